@@ -0,0 +1,150 @@
+// Package diff provides the CLI command for comparing a Typesense cluster with Terraform configuration
+package diff
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/alanm/terraform-provider-typesense/internal/generator"
+)
+
+// ErrDifferences is returned by Run when the server and the configuration differ.
+var ErrDifferences = errors.New("the server and the configuration differ")
+
+// Run executes the diff command with the given arguments
+func Run(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+
+	// Server connection flags
+	host := fs.String("host", "", "Typesense server hostname")
+	port := fs.Int("port", 8108, "Typesense server port")
+	protocol := fs.String("protocol", "http", "Typesense server protocol (http or https)")
+	apiKey := fs.String("api-key", "", "Typesense server API key")
+
+	// Cloud connection flags
+	cloudAPIKey := fs.String("cloud-api-key", "", "Typesense Cloud Management API key")
+
+	// Configuration flags
+	configDir := fs.String("config-dir", ".", "Directory containing the Terraform configuration to compare")
+
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, `Usage: terraform-provider-typesense diff [options]
+
+Compare the resources on a Typesense cluster with a Terraform configuration,
+without running Terraform. Exits with status 2 when they differ.
+
+Only attributes set in the configuration are compared. Values that need
+Terraform to resolve, such as variables, are skipped.
+
+Options:
+`)
+		fs.PrintDefaults()
+		fmt.Fprintf(os.Stderr, `
+Examples:
+  # Compare a local server with the configuration in ./infra
+  terraform-provider-typesense diff \
+    --host=localhost --port=8108 --protocol=http --api-key=xyz \
+    --config-dir=./infra
+
+  # Also compare Typesense Cloud clusters
+  terraform-provider-typesense diff \
+    --host=my-cluster.typesense.net --port=443 --protocol=https --api-key=xyz \
+    --cloud-api-key=abc123 \
+    --config-dir=./infra
+`)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Validate that at least one connection is configured
+	hasServerConfig := *host != "" && *apiKey != ""
+	hasCloudConfig := *cloudAPIKey != ""
+
+	if !hasServerConfig && !hasCloudConfig {
+		return fmt.Errorf("at least one of server credentials (--host, --api-key) or cloud credentials (--cloud-api-key) is required")
+	}
+
+	if *host != "" && *apiKey == "" {
+		return fmt.Errorf("--api-key is required when --host is specified")
+	}
+
+	gen := generator.New(&generator.Config{
+		Host:        *host,
+		Port:        *port,
+		Protocol:    *protocol,
+		APIKey:      *apiKey,
+		CloudAPIKey: *cloudAPIKey,
+	})
+
+	ctx := context.Background()
+	if err := gen.DetectServerVersion(ctx); err != nil {
+		return fmt.Errorf("server version detection failed: %w", err)
+	}
+	result, err := gen.Diff(ctx, *configDir)
+	if err != nil {
+		return fmt.Errorf("diff failed: %w", err)
+	}
+
+	printResult(os.Stdout, result)
+	if len(result.Changes) > 0 {
+		return ErrDifferences
+	}
+	return nil
+}
+
+var actionSymbols = map[generator.ChangeAction]string{
+	generator.ChangeCreate: "+",
+	generator.ChangeUpdate: "~",
+	generator.ChangeDelete: "-",
+}
+
+var actionDescriptions = map[generator.ChangeAction]string{
+	generator.ChangeCreate: "is not on the server and would be created",
+	generator.ChangeUpdate: "differs from the server and would be updated",
+	generator.ChangeDelete: "is on the server but not in the configuration",
+}
+
+// printResult writes a human-readable summary of result to w.
+func printResult(w io.Writer, result *generator.DiffResult) {
+	counts := make(map[generator.ChangeAction]int)
+	for _, change := range result.Changes {
+		counts[change.Action]++
+		fmt.Fprintf(w, "%s %s (%s) %s\n", actionSymbols[change.Action], change.Address, change.ID, actionDescriptions[change.Action])
+		for _, attr := range change.Attributes {
+			fmt.Fprintf(w, "    %s: %s => %s\n", attr.Path, valueOrUnset(attr.Server), valueOrUnset(attr.Config))
+		}
+	}
+
+	if len(result.Skipped) > 0 {
+		if len(result.Changes) > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, "Not compared:")
+		for _, skipped := range result.Skipped {
+			fmt.Fprintf(w, "  %s\n", skipped)
+		}
+	}
+
+	if len(result.Changes) > 0 || len(result.Skipped) > 0 {
+		fmt.Fprintln(w)
+	}
+	if len(result.Changes) == 0 {
+		fmt.Fprintln(w, "No differences. The server matches the configuration.")
+		return
+	}
+	fmt.Fprintf(w, "Diff: %d to create, %d to update, %d to delete. Attribute values are shown as server => configuration.\n",
+		counts[generator.ChangeCreate], counts[generator.ChangeUpdate], counts[generator.ChangeDelete])
+}
+
+func valueOrUnset(value string) string {
+	if value == "" {
+		return "(not set)"
+	}
+	return value
+}
@@ -6,8 +6,11 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/alanm/terraform-provider-typesense/internal/generator"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
 )
 
 // Run executes the generate command with the given arguments
@@ -25,11 +28,17 @@ func Run(args []string) error {
 
 	// Output flags
 	output := fs.String("output", "./generated", "Output directory for generated files")
+	fs.StringVar(output, "output-dir", *output, "Same as --output")
 	singleFile := fs.Bool("single-file", false, "Write all resources to a single main.tf instead of separate files")
+	split := fs.Bool("split", false, "Write one file per resource type (the default; cannot be combined with --single-file)")
 
 	// Data export flags
 	includeData := fs.Bool("include-data", false, "Export document data to JSONL files for migration")
 
+	// Resource selection flags
+	include := fs.String("include", "", "Comma-separated resource types to generate (default: all)")
+	exclude := fs.String("exclude", "", "Comma-separated resource types to skip")
+
 	fs.Usage = func() {
 		fmt.Fprintf(os.Stderr, `Usage: terraform-provider-typesense generate [options]
 
@@ -67,7 +76,16 @@ Examples:
     --host=localhost --api-key=xyz \
     --single-file \
     --output=./generated
-`)
+
+  # Generate only collections and their synonyms
+  terraform-provider-typesense generate \
+    --host=localhost --api-key=xyz \
+    --include=collection,synonym \
+    --output=./generated
+
+Resource types for --include and --exclude:
+  %s
+`, strings.Join(tfnames.GeneratedResourceNames, ", "))
 	}
 
 	if err := fs.Parse(args); err != nil {
@@ -82,11 +100,24 @@ Examples:
 		return fmt.Errorf("at least one of server credentials (--host, --api-key) or cloud credentials (--cloud-api-key) is required")
 	}
 
+	if *split && *singleFile {
+		return fmt.Errorf("--split and --single-file cannot be used together")
+	}
+
 	// Set defaults for server config if host is provided
 	if *host != "" && *apiKey == "" {
 		return fmt.Errorf("--api-key is required when --host is specified")
 	}
 
+	includeTypes, err := parseResourceTypes("include", *include)
+	if err != nil {
+		return err
+	}
+	excludeTypes, err := parseResourceTypes("exclude", *exclude)
+	if err != nil {
+		return err
+	}
+
 	// Create generator config
 	cfg := &generator.Config{
 		Host:        *host,
@@ -97,6 +128,8 @@ Examples:
 		OutputDir:   *output,
 		SingleFile:  *singleFile,
 		IncludeData: *includeData,
+		Include:     includeTypes,
+		Exclude:     excludeTypes,
 	}
 
 	// Run generator
@@ -112,6 +145,12 @@ Examples:
 			fmt.Printf("  Scope: cluster matching %s\n", *host)
 		}
 	}
+	if len(includeTypes) > 0 {
+		fmt.Printf("  Include: %s\n", strings.Join(includeTypes, ", "))
+	}
+	if len(excludeTypes) > 0 {
+		fmt.Printf("  Exclude: %s\n", strings.Join(excludeTypes, ", "))
+	}
 	fmt.Printf("  Output: %s\n", *output)
 	if *singleFile {
 		fmt.Printf("  Mode: single file (main.tf)\n")
@@ -185,3 +224,24 @@ Examples:
 
 	return nil
 }
+
+// parseResourceTypes splits a comma-separated --include or --exclude value
+// and checks each entry is a resource type generate knows.
+func parseResourceTypes(flagName, value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var types []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimPrefix(strings.TrimSpace(name), tfnames.ProviderTypeName+"_")
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(tfnames.GeneratedResourceNames, name) {
+			return nil, fmt.Errorf("--%s: unknown resource type %q (valid types: %s)", flagName, name, strings.Join(tfnames.GeneratedResourceNames, ", "))
+		}
+		types = append(types, name)
+	}
+	return types, nil
+}
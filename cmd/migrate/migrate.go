@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/alanm/terraform-provider-typesense/internal/migrator"
 )
@@ -26,6 +27,10 @@ func Run(args []string) error {
 	// Data import flags
 	includeDocuments := fs.Bool("include-documents", false, "Import document data from JSONL files (can be very large!)")
 
+	// v29 -> v30 upgrade flags
+	upgradeCurations := fs.Bool("upgrade-curations", false, "Convert per-collection synonyms/overrides on the target into v30 synonym/curation sets (no --source-dir needed)")
+	collections := fs.String("collections", "", "Comma-separated collections to convert with --upgrade-curations (default: all)")
+
 	fs.Usage = func() {
 		fmt.Fprintf(os.Stderr, `Usage: terraform-provider-typesense migrate [options]
 
@@ -51,6 +56,12 @@ Examples:
     --target-api-key=$TARGET_API_KEY \
     --include-documents
 
+  # Convert v29 per-collection synonyms/overrides into v30 sets
+  terraform-provider-typesense migrate \
+    --upgrade-curations \
+    --target-host=target.typesense.net --target-port=443 --target-protocol=https \
+    --target-api-key=$TARGET_API_KEY
+
 Workflow:
   1. Export from source cluster:
      terraform-provider-typesense generate \
@@ -73,6 +84,9 @@ Workflow:
 	}
 
 	// Validate required flags
+	if *upgradeCurations {
+		return runUpgradeCurations(*targetHost, *targetPort, *targetProtocol, *targetAPIKey, *collections)
+	}
 	if *sourceDir == "" {
 		return fmt.Errorf("--source-dir is required")
 	}
@@ -134,3 +148,39 @@ Workflow:
 
 	return nil
 }
+
+// runUpgradeCurations converts per-collection synonyms and overrides on the
+// target into v30 synonym and curation sets.
+func runUpgradeCurations(host string, port int, protocol, apiKey, collections string) error {
+	if host == "" {
+		return fmt.Errorf("--target-host is required")
+	}
+	if apiKey == "" {
+		return fmt.Errorf("--target-api-key is required")
+	}
+
+	var names []string
+	for _, name := range strings.Split(collections, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	m := migrator.New(&migrator.Config{
+		TargetHost:     host,
+		TargetPort:     port,
+		TargetProtocol: protocol,
+		TargetAPIKey:   apiKey,
+	})
+
+	fmt.Printf("Upgrading curations on %s://%s:%d\n\n", protocol, host, port)
+
+	if err := m.UpgradeCurations(context.Background(), names); err != nil {
+		return fmt.Errorf("curation upgrade failed: %w", err)
+	}
+
+	fmt.Println()
+	fmt.Printf("Curation upgrade complete!\n")
+
+	return nil
+}
@@ -175,6 +175,7 @@ resource "typesense_collection" "albums" {
 resource "typesense_collection" "artists" {
   name                 = "artists"
   enable_nested_fields = true
+  synonym_sets         = [typesense_synonym_set.artist_names.name]
 
   # Primary key
   field {
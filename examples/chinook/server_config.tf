@@ -0,0 +1,10 @@
+# Runtime Server Settings for Chinook Music Database
+# Tune the server through its /config endpoint
+
+# =============================================================================
+# SLOW REQUEST LOGGING
+# Log searches over the catalog that take longer than two seconds
+# =============================================================================
+resource "typesense_config" "server" {
+  log_slow_requests_time_ms = 2000
+}
@@ -156,3 +156,28 @@ resource "typesense_synonym" "playlist_classical" {
   name       = "playlist-classical-synonyms"
   synonyms   = ["classical", "classic", "orchestra", "orchestral"]
 }
+
+# =============================================================================
+# ARTIST NAME SYNONYM SET (v30)
+# One set owns all of its items; attached to the artists collection
+# =============================================================================
+
+resource "typesense_synonym_set" "artist_names" {
+  name = "artist-name-synonyms"
+
+  item {
+    id       = "acdc"
+    synonyms = ["ac/dc", "acdc", "ac dc"]
+  }
+
+  item {
+    id       = "guns-n-roses"
+    synonyms = ["guns n' roses", "guns n roses", "guns and roses", "gnr"]
+  }
+
+  item {
+    id       = "red-hot-chili-peppers"
+    root     = "red hot chili peppers"
+    synonyms = ["rhcp", "chili peppers"]
+  }
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// circuitBreakerTransport stops sending requests to a host after threshold
+// consecutive failures. While the circuit is open, requests to that host fail
+// immediately instead of waiting for connection timeouts. Once the cooldown
+// has passed, the circuit is half-open: a single request probes the host via
+// /health while the others keep failing fast. The host is only restored when
+// the probe reports ok; a failed probe keeps it out for another cooldown.
+//
+// State is kept per host (scheme and host:port), so every node a client talks
+// to is tracked separately.
+type circuitBreakerTransport struct {
+	next      http.RoundTripper
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	// basePath is the server's path prefix, which the /health probe needs
+	// behind a reverse proxy.
+	basePath string
+
+	mu    sync.Mutex
+	hosts map[string]*hostCircuit
+}
+
+// hostCircuit is the breaker state for a single host. openUntil is zero while
+// the circuit is closed; probing is set while a half-open probe is in flight.
+type hostCircuit struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newCircuitBreakerTransport(next http.RoundTripper, threshold int, cooldown time.Duration) *circuitBreakerTransport {
+	return &circuitBreakerTransport{
+		next:      next,
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		hosts:     make(map[string]*hostCircuit),
+	}
+}
+
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Scheme + "://" + req.URL.Host
+
+	t.mu.Lock()
+	circuit, ok := t.hosts[host]
+	if !ok {
+		circuit = &hostCircuit{}
+		t.hosts[host] = circuit
+	}
+	t.mu.Unlock()
+
+	if err := t.admit(req, host, circuit); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err == nil && !isUnavailableStatus(resp.StatusCode) {
+		circuit.failures = 0
+		return resp, nil
+	}
+
+	circuit.failures++
+	if circuit.failures >= t.threshold && circuit.openUntil.IsZero() {
+		circuit.openUntil = t.now().Add(t.cooldown)
+		tflog.Warn(req.Context(), "Typesense host failing, opening circuit breaker", map[string]any{
+			"host":             host,
+			"failures":         circuit.failures,
+			"cooldown_seconds": t.cooldown.Seconds(),
+		})
+	}
+	return resp, err
+}
+
+// admit returns an error when the circuit of host does not let req through.
+// After the cooldown, the first request to arrive probes the host; requests
+// arriving while that probe is in flight fail as if the circuit were open.
+func (t *circuitBreakerTransport) admit(req *http.Request, host string, circuit *hostCircuit) error {
+	t.mu.Lock()
+	switch {
+	case circuit.openUntil.IsZero():
+		t.mu.Unlock()
+		return nil
+	case t.now().Before(circuit.openUntil):
+		openUntil := circuit.openUntil
+		t.mu.Unlock()
+		return fmt.Errorf("circuit breaker open for %s after %d consecutive failures, retrying after %s",
+			host, t.threshold, openUntil.Format(time.RFC3339))
+	case circuit.probing:
+		t.mu.Unlock()
+		return fmt.Errorf("circuit breaker open for %s: another request is checking its health", host)
+	}
+	circuit.probing = true
+	t.mu.Unlock()
+
+	healthy := t.probe(req, host)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	circuit.probing = false
+	if !healthy {
+		circuit.openUntil = t.now().Add(t.cooldown)
+		return fmt.Errorf("circuit breaker open for %s: health check failed after cooldown", host)
+	}
+	tflog.Info(req.Context(), "Typesense host healthy again, closing circuit breaker", map[string]any{"host": host})
+	circuit.failures = 0
+	circuit.openUntil = time.Time{}
+	return nil
+}
+
+// probe reports whether host answers /health with ok = true.
+func (t *circuitBreakerTransport) probe(req *http.Request, host string) bool {
+	probeReq, err := http.NewRequestWithContext(req.Context(), http.MethodGet, host+t.basePath+"/health", nil)
+	if err != nil {
+		return false
+	}
+	// Proxies in front of the server may require the same headers as the
+	// request being guarded.
+	probeReq.Header = req.Header.Clone()
+
+	resp, err := t.next.RoundTrip(probeReq)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	var result struct {
+		OK bool `json:"ok"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false
+	}
+	return result.OK
+}
+
+// isUnavailableStatus reports whether a response means the node itself is
+// unavailable, as opposed to rejecting the request.
+func isUnavailableStatus(status int) bool {
+	return status == http.StatusBadGateway ||
+		status == http.StatusServiceUnavailable ||
+		status == http.StatusGatewayTimeout
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyNode is a test server that answers 503 on every path, /health
+// included, while down is set.
+type flakyNode struct {
+	down          atomic.Bool
+	requests      atomic.Int32
+	healthProbes  atomic.Int32
+	collectionHit atomic.Int32
+}
+
+func (n *flakyNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n.requests.Add(1)
+	if r.URL.Path == "/health" {
+		n.healthProbes.Add(1)
+	} else {
+		n.collectionHit.Add(1)
+	}
+
+	if n.down.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"ok": false}`))
+		return
+	}
+
+	if r.URL.Path == "/health" {
+		_, _ = w.Write([]byte(`{"ok": true}`))
+		return
+	}
+	_, _ = w.Write([]byte(`{"name": "products", "fields": []}`))
+}
+
+func newCircuitBreakerTestClient(t *testing.T, node *flakyNode, threshold int, cooldown time.Duration) (*ServerClient, *time.Time) {
+	t.Helper()
+
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	c := NewServerClient("unused", "test-key", 0, "http")
+	c.baseURL = server.URL
+	c.ConfigureCircuitBreaker(threshold, cooldown)
+
+	clock := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := c.httpClient.Transport.(*loggingTransport).next.(*circuitBreakerTransport)
+	breaker.now = func() time.Time { return clock }
+	return c, &clock
+}
+
+func TestCircuitBreakerSkipsFailingHostUntilHealthy(t *testing.T) {
+	ctx := context.Background()
+	node := &flakyNode{}
+	node.down.Store(true)
+	c, clock := newCircuitBreakerTestClient(t, node, 2, 30*time.Second)
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetCollection(ctx, "products"); err == nil {
+			t.Fatalf("request %d succeeded against a down node, want error", i+1)
+		}
+	}
+	if got := node.collectionHit.Load(); got != 2 {
+		t.Fatalf("node received %d requests before the breaker opened, want 2", got)
+	}
+
+	// Open: requests fail without reaching the node.
+	_, err := c.GetCollection(ctx, "products")
+	if err == nil || !strings.Contains(err.Error(), "circuit breaker open") {
+		t.Fatalf("GetCollection error = %v, want circuit breaker open", err)
+	}
+	if got := node.requests.Load(); got != 2 {
+		t.Fatalf("node received %d requests while the breaker was open, want 2", got)
+	}
+
+	// Cooldown elapsed but the node is still down: the probe fails and the
+	// request is not sent.
+	*clock = clock.Add(31 * time.Second)
+	if _, err := c.GetCollection(ctx, "products"); err == nil || !strings.Contains(err.Error(), "health check failed") {
+		t.Fatalf("GetCollection error = %v, want failed health check", err)
+	}
+	if got := node.healthProbes.Load(); got != 1 {
+		t.Fatalf("health probes = %d, want 1", got)
+	}
+	if got := node.collectionHit.Load(); got != 2 {
+		t.Fatalf("node received %d collection requests after a failed probe, want 2", got)
+	}
+
+	// The failed probe started a new cooldown.
+	*clock = clock.Add(10 * time.Second)
+	if _, err := c.GetCollection(ctx, "products"); err == nil || !strings.Contains(err.Error(), "circuit breaker open") {
+		t.Fatalf("GetCollection error = %v, want circuit breaker open", err)
+	}
+
+	// The node recovers: the next probe passes and the request goes through.
+	node.down.Store(false)
+	*clock = clock.Add(31 * time.Second)
+	col, err := c.GetCollection(ctx, "products")
+	if err != nil {
+		t.Fatalf("GetCollection after recovery: %v", err)
+	}
+	if col == nil || col.Name != "products" {
+		t.Fatalf("GetCollection after recovery = %+v, want products", col)
+	}
+	if got := node.healthProbes.Load(); got != 2 {
+		t.Fatalf("health probes = %d, want 2", got)
+	}
+}
+
+func TestCircuitBreakerSendsOneProbeWhenHalfOpen(t *testing.T) {
+	ctx := context.Background()
+	var down atomic.Bool
+	var probes atomic.Int32
+	probing := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			if probes.Add(1) == 1 {
+				probing <- struct{}{}
+				<-release
+			}
+			_, _ = w.Write([]byte(`{"ok": true}`))
+			return
+		}
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "products", "fields": []}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewServerClient("unused", "test-key", 0, "http")
+	c.baseURL = server.URL
+	c.ConfigureCircuitBreaker(1, 30*time.Second)
+	clock := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := c.httpClient.Transport.(*loggingTransport).next.(*circuitBreakerTransport)
+	breaker.now = func() time.Time { return clock }
+
+	down.Store(true)
+	if _, err := c.GetCollection(ctx, "products"); err == nil {
+		t.Fatal("request succeeded against a down node, want error")
+	}
+	down.Store(false)
+	clock = clock.Add(31 * time.Second)
+
+	// The first request after the cooldown probes the host; a second one
+	// arriving meanwhile is turned away instead of probing too.
+	probed := make(chan error)
+	go func() {
+		_, err := c.GetCollection(ctx, "products")
+		probed <- err
+	}()
+	<-probing
+
+	if _, err := c.GetCollection(ctx, "products"); err == nil || !strings.Contains(err.Error(), "circuit breaker open") {
+		t.Errorf("GetCollection during the probe error = %v, want circuit breaker open", err)
+	}
+
+	close(release)
+	if err := <-probed; err != nil {
+		t.Fatalf("GetCollection after a passing probe: %v", err)
+	}
+	if _, err := c.GetCollection(ctx, "products"); err != nil {
+		t.Fatalf("GetCollection once the circuit closed: %v", err)
+	}
+	if got := probes.Load(); got != 1 {
+		t.Errorf("health probes = %d, want 1", got)
+	}
+}
+
+func TestCircuitBreakerResetsOnSuccess(t *testing.T) {
+	ctx := context.Background()
+	node := &flakyNode{}
+	c, _ := newCircuitBreakerTestClient(t, node, 2, 30*time.Second)
+
+	// Failures that are not consecutive never open the circuit.
+	for i := 0; i < 3; i++ {
+		node.down.Store(true)
+		if _, err := c.GetCollection(ctx, "products"); err == nil {
+			t.Fatalf("request %d succeeded against a down node, want error", i+1)
+		}
+		node.down.Store(false)
+		if _, err := c.GetCollection(ctx, "products"); err != nil {
+			t.Fatalf("request %d after recovery: %v", i+1, err)
+		}
+	}
+	if got := node.healthProbes.Load(); got != 0 {
+		t.Fatalf("health probes = %d, want 0", got)
+	}
+}
+
+func TestConfigureCircuitBreakerDisabledByDefault(t *testing.T) {
+	c := NewServerClient("localhost", "test-key", 8108, "http")
+	c.ConfigureCircuitBreaker(0, 30*time.Second)
+
+	if _, ok := c.httpClient.Transport.(*loggingTransport).next.(*circuitBreakerTransport); ok {
+		t.Fatal("threshold 0 installed a circuit breaker, want it disabled")
+	}
+}
@@ -6,13 +6,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"strings"
 	"time"
 )
 
 const (
 	CloudAPIBaseURL = "https://cloud.typesense.org/api/v1"
+
+	// defaultClusterPollInterval and defaultClusterPollMaxInterval bound the
+	// backoff of WaitForClusterReady.
+	defaultClusterPollInterval    = 5 * time.Second
+	defaultClusterPollMaxInterval = 30 * time.Second
 )
 
 // CloudClient handles communication with the Typesense Cloud Management API
@@ -20,6 +25,12 @@ type CloudClient struct {
 	httpClient *http.Client
 	apiKey     string
 	baseURL    string
+	userAgent  string
+
+	// pollInterval is the first wait of WaitForClusterReady; it doubles after
+	// every poll up to pollMaxInterval. Zero values use the defaults.
+	pollInterval    time.Duration
+	pollMaxInterval time.Duration
 }
 
 // NewCloudClient creates a new Cloud Management API client
@@ -28,11 +39,32 @@ func NewCloudClient(apiKey string) *CloudClient {
 		httpClient: &http.Client{
 			Timeout: 60 * time.Second,
 		},
-		apiKey:  apiKey,
-		baseURL: CloudAPIBaseURL,
+		apiKey:    apiKey,
+		baseURL:   CloudAPIBaseURL,
+		userAgent: UserAgent("", ""),
 	}
 }
 
+// SetUserAgent sets the User-Agent header sent with every request, see
+// UserAgent.
+func (c *CloudClient) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// SetBaseURL points the client at a different Cloud Management API endpoint,
+// e.g. a proxy or a mock. A trailing slash is ignored.
+func (c *CloudClient) SetBaseURL(baseURL string) {
+	c.baseURL = strings.TrimRight(baseURL, "/")
+}
+
+// SetClusterPollInterval sets the first wait of WaitForClusterReady and the
+// cap its exponential backoff grows to. Zero keeps the default of 5s and 30s
+// respectively.
+func (c *CloudClient) SetClusterPollInterval(initial, maxInterval time.Duration) {
+	c.pollInterval = initial
+	c.pollMaxInterval = maxInterval
+}
+
 // Cluster represents a Typesense Cloud cluster
 type Cluster struct {
 	ID                     string           `json:"id,omitempty"`
@@ -97,7 +129,7 @@ func (c *CloudClient) CreateCluster(ctx context.Context, cluster *Cluster) (*Clu
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create cluster: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -129,7 +161,7 @@ func (c *CloudClient) GetCluster(ctx context.Context, clusterID string) (*Cluste
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get cluster: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -162,7 +194,7 @@ func (c *CloudClient) UpdateCluster(ctx context.Context, clusterID string, clust
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to update cluster: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -189,42 +221,64 @@ func (c *CloudClient) DeleteCluster(ctx context.Context, clusterID string) error
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "cluster", clusterID)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete cluster: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
 	return nil
 }
 
-// WaitForClusterReady polls until the cluster is in_service
+// WaitForClusterReady polls until the cluster is in_service. The wait
+// between polls starts at the poll interval and doubles up to the maximum
+// (see SetClusterPollInterval). Any other status, such as provisioning or
+// scaling, is transitional and polled again; failed and terminated are final
+// and end the wait at once instead of running into the timeout.
 func (c *CloudClient) WaitForClusterReady(ctx context.Context, clusterID string, timeout time.Duration) (*Cluster, error) {
+	interval, maxInterval := c.pollInterval, c.pollMaxInterval
+	if interval <= 0 {
+		interval = defaultClusterPollInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = defaultClusterPollMaxInterval
+	}
+
 	deadline := time.Now().Add(timeout)
-	ticker := time.NewTicker(30 * time.Second)
-	defer ticker.Stop()
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
-		case <-ticker.C:
-			if time.Now().After(deadline) {
-				return nil, fmt.Errorf("timeout waiting for cluster to be ready")
-			}
-
-			cluster, err := c.GetCluster(ctx, clusterID)
-			if err != nil {
-				return nil, err
-			}
-
-			if cluster.Status == "in_service" {
-				return cluster, nil
-			}
-
-			if cluster.Status == "failed" || cluster.Status == "terminated" {
-				return nil, fmt.Errorf("cluster entered %s state", cluster.Status)
-			}
+		case <-timer.C:
+		}
+
+		cluster, err := c.GetCluster(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		if cluster == nil {
+			return nil, fmt.Errorf("cluster %s no longer exists", clusterID)
 		}
+
+		switch cluster.Status {
+		case "in_service":
+			return cluster, nil
+		case "failed", "terminated":
+			return nil, fmt.Errorf("cluster entered %s state", cluster.Status)
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout waiting for cluster to be ready: still %s after %s", cluster.Status, timeout)
+		}
+
+		interval = min(interval*2, maxInterval)
+		timer.Reset(interval)
 	}
 }
 
@@ -249,7 +303,7 @@ func (c *CloudClient) CreateClusterConfigChange(ctx context.Context, change *Clu
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create config change: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -281,7 +335,7 @@ func (c *CloudClient) GetClusterConfigChange(ctx context.Context, clusterID, cha
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get config change: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -308,8 +362,12 @@ func (c *CloudClient) DeleteClusterConfigChange(ctx context.Context, clusterID,
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "cluster config change", clusterID+"/"+changeID)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete config change: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -332,7 +390,7 @@ func (c *CloudClient) GenerateClusterAPIKeys(ctx context.Context, clusterID stri
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to generate API keys: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -345,6 +403,9 @@ func (c *CloudClient) GenerateClusterAPIKeys(ctx context.Context, clusterID stri
 }
 
 func (c *CloudClient) setHeaders(req *http.Request) {
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-TYPESENSE-CLOUD-MANAGEMENT-API-KEY", c.apiKey)
 }
@@ -365,7 +426,7 @@ func (c *CloudClient) ListClusters(ctx context.Context) ([]Cluster, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list clusters: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -6,8 +6,10 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // TestCreateClusterConfigChange_Payload validates that the config change request
@@ -107,8 +109,8 @@ func TestWaitForClusterReady_AfterConfigChange(t *testing.T) {
 		baseURL:    server.URL,
 	}
 
-	// Use a short poll interval for testing — WaitForClusterReady uses 30s ticker
-	// so we test via GetCluster directly to verify status handling
+	// Test via GetCluster directly to verify status handling; the polling
+	// itself is covered by the TestWaitForClusterReady* tests below.
 	cluster, err := client.GetCluster(context.Background(), "cluster-abc")
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
@@ -258,3 +260,106 @@ func TestUpdateCluster_DirectFieldsOnly(t *testing.T) {
 		t.Errorf("Expected name=new-name, got %v", payload["name"])
 	}
 }
+
+// TestSetBaseURL_RoutesClusterRequests validates that a configured Cloud API
+// URL replaces the public endpoint and ignores a trailing slash.
+func TestSetBaseURL_RoutesClusterRequests(t *testing.T) {
+	var capturedPath, capturedKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedPath = r.URL.Path
+		capturedKey = r.Header.Get("X-TYPESENSE-CLOUD-MANAGEMENT-API-KEY")
+		_ = json.NewEncoder(w).Encode(Cluster{ID: "cluster-abc", Name: "production", Status: "in_service"})
+	}))
+	defer server.Close()
+
+	client := NewCloudClient("cloud-key")
+	client.SetBaseURL(server.URL + "/api/v1/")
+
+	cluster, err := client.GetCluster(context.Background(), "cluster-abc")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cluster == nil || cluster.Name != "production" {
+		t.Fatalf("Expected cluster production, got %+v", cluster)
+	}
+	if capturedPath != "/api/v1/clusters/cluster-abc" {
+		t.Errorf("Expected path /api/v1/clusters/cluster-abc, got %s", capturedPath)
+	}
+	if capturedKey != "cloud-key" {
+		t.Errorf("Expected the cloud API key to be sent, got %q", capturedKey)
+	}
+}
+
+// clusterStatusServer serves the given statuses for successive GetCluster
+// calls, repeating the last one, and counts the polls. An empty status answers
+// 404, as for a cluster deleted while it is polled.
+func clusterStatusServer(t *testing.T, statuses ...string) (*CloudClient, *int32) {
+	t.Helper()
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/clusters/cluster-abc" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		n := int(atomic.AddInt32(&polls, 1))
+		status := statuses[min(n, len(statuses))-1]
+		if status == "" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(Cluster{ID: "cluster-abc", Status: status})
+	}))
+	t.Cleanup(server.Close)
+
+	client := &CloudClient{httpClient: server.Client(), apiKey: "test-key", baseURL: server.URL}
+	client.SetClusterPollInterval(time.Millisecond, 4*time.Millisecond)
+	return client, &polls
+}
+
+func TestWaitForClusterReadyPollsThroughTransitionalStates(t *testing.T) {
+	client, polls := clusterStatusServer(t, "provisioning", "provisioning", "scaling", "in_service")
+
+	cluster, err := client.WaitForClusterReady(context.Background(), "cluster-abc", time.Minute)
+	if err != nil {
+		t.Fatalf("WaitForClusterReady returned %v", err)
+	}
+	if cluster.Status != "in_service" {
+		t.Errorf("status = %s, want in_service", cluster.Status)
+	}
+	if got := atomic.LoadInt32(polls); got != 4 {
+		t.Errorf("polls = %d, want 4", got)
+	}
+}
+
+func TestWaitForClusterReadyFailsFastOnFailedState(t *testing.T) {
+	client, polls := clusterStatusServer(t, "provisioning", "failed")
+
+	_, err := client.WaitForClusterReady(context.Background(), "cluster-abc", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "failed state") {
+		t.Fatalf("error = %v, want the failed state", err)
+	}
+	if got := atomic.LoadInt32(polls); got != 2 {
+		t.Errorf("polls = %d, want 2: a failed cluster must not be polled until the timeout", got)
+	}
+}
+
+func TestWaitForClusterReadyFailsWhenClusterIsDeleted(t *testing.T) {
+	client, polls := clusterStatusServer(t, "provisioning", "")
+
+	_, err := client.WaitForClusterReady(context.Background(), "cluster-abc", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "cluster cluster-abc no longer exists") {
+		t.Fatalf("error = %v, want the deleted cluster reported", err)
+	}
+	if got := atomic.LoadInt32(polls); got != 2 {
+		t.Errorf("polls = %d, want 2", got)
+	}
+}
+
+func TestWaitForClusterReadyTimesOut(t *testing.T) {
+	client, _ := clusterStatusServer(t, "provisioning")
+
+	_, err := client.WaitForClusterReady(context.Background(), "cluster-abc", 10*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "still provisioning") {
+		t.Fatalf("error = %v, want a timeout naming the last status", err)
+	}
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// versionGatedEndpoints maps endpoints that only exist from a known Typesense
+// release onwards to that release. A 404 from the endpoint itself (as opposed
+// to one of the objects under it) means the server predates the feature.
+var versionGatedEndpoints = map[string]string{
+	"synonym_sets":     "30.0",
+	"curation_sets":    "30.0",
+	"nl_search_models": "29.0",
+}
+
+// VersionUnsupportedError is returned when a version-gated endpoint answers
+// 404, so callers can report the Typesense version a feature needs instead of
+// a bare "not found".
+type VersionUnsupportedError struct {
+	// Endpoint is the top-level path of the endpoint, e.g. "synonym_sets".
+	Endpoint string
+	// MinVersion is the first Typesense release that serves it, e.g. "30.0".
+	MinVersion string
+}
+
+func (e *VersionUnsupportedError) Error() string {
+	return fmt.Sprintf("the /%s endpoint is not available on this server: this feature requires Typesense v%s or later", e.Endpoint, e.MinVersion)
+}
+
+// newVersionUnsupportedError returns the error for a 404 from endpoint, which
+// must be a key of versionGatedEndpoints.
+func newVersionUnsupportedError(endpoint string) error {
+	return &VersionUnsupportedError{Endpoint: endpoint, MinVersion: versionGatedEndpoints[endpoint]}
+}
+
+// IsVersionUnsupported reports whether err, or any error it wraps, is a
+// VersionUnsupportedError.
+func IsVersionUnsupported(err error) bool {
+	var versionErr *VersionUnsupportedError
+	return errors.As(err, &versionErr)
+}
+
+// ConflictError is returned when the server rejects a write because the
+// object was modified concurrently (409 Conflict), so callers can re-read it
+// and try again.
+type ConflictError struct {
+	// Resource describes what was written, e.g. "synonym set products".
+	Resource string
+	// Body is the server's error response.
+	Body string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s was modified concurrently: status 409, body: %s", e.Resource, e.Body)
+}
+
+// IsConflict reports whether err, or any error it wraps, is a ConflictError.
+func IsConflict(err error) bool {
+	var conflictErr *ConflictError
+	return errors.As(err, &conflictErr)
+}
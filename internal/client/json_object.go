@@ -0,0 +1,24 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSONObject is a free-form JSON object such as collection metadata or a
+// preset value. Numbers decode as json.Number instead of float64, so an
+// integer stays an integer, large integers keep every digit, and encoding
+// the object again writes each number the way it was received.
+type JSONObject map[string]any
+
+// UnmarshalJSON decodes data with UseNumber.
+func (o *JSONObject) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var object map[string]any
+	if err := decoder.Decode(&object); err != nil {
+		return err
+	}
+	*o = object
+	return nil
+}
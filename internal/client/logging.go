@@ -0,0 +1,93 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedQueryParams are query parameters that can carry credentials. Typesense
+// accepts the API key as a query parameter as well as a header.
+var redactedQueryParams = []string{"x-typesense-api-key"}
+
+// loggingTransport writes a tflog debug entry for every request: method, path,
+// status and duration. With logBodies set it also logs the request and
+// response bodies, which is what TF_LOG=debug users need when the server
+// returns a vague 400.
+type loggingTransport struct {
+	next      http.RoundTripper
+	apiKey    string
+	logBodies bool
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.MaskAllFieldValuesStrings(req.Context(), t.apiKey)
+
+	var reqBody []byte
+	if t.logBodies && req.Body != nil {
+		reqBody, _ = io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields := map[string]any{
+		"method":      req.Method,
+		"path":        redactedPath(req.URL),
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Typesense API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	if t.logBodies {
+		respBody, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		if readErr == nil {
+			fields["response_body"] = string(respBody)
+		}
+		if len(reqBody) > 0 {
+			fields["request_body"] = string(reqBody)
+		}
+	}
+
+	tflog.Debug(ctx, "Typesense API request", fields)
+	return resp, nil
+}
+
+// redactedPath returns the request path and query with credential-bearing
+// query parameters replaced.
+func redactedPath(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.EscapedPath()
+	}
+
+	query := u.Query()
+	for key := range query {
+		for _, secret := range redactedQueryParams {
+			if strings.EqualFold(key, secret) {
+				query.Set(key, "REDACTED")
+			}
+		}
+	}
+	return u.EscapedPath() + "?" + query.Encode()
+}
+
+// logAlreadyAbsent notes a delete that found nothing to delete. A 404 on
+// delete is treated as success; the log entry explains applies in which the
+// object was removed outside Terraform.
+func logAlreadyAbsent(ctx context.Context, kind, id string) {
+	tflog.Debug(ctx, "Resource already absent, nothing to delete", map[string]any{"kind": kind, "id": id})
+}
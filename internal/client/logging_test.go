@@ -0,0 +1,143 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransportLogsRequestSummary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "Bad JSON."}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	c := NewServerClient("unused", "secret-admin-key", 0, "http")
+	c.baseURL = server.URL
+
+	if _, err := c.CreateCollection(ctx, &Collection{Name: "products"}); err == nil {
+		t.Fatal("CreateCollection succeeded, want error")
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("failed to decode logs: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1: %v", len(entries), entries)
+	}
+
+	entry := entries[0]
+	if entry["@level"] != "debug" || entry["method"] != http.MethodPost || entry["path"] != "/collections" {
+		t.Errorf("log entry = %v, want a debug entry for POST /collections", entry)
+	}
+	if entry["status"] != float64(http.StatusBadRequest) {
+		t.Errorf("status = %v, want 400", entry["status"])
+	}
+	if _, ok := entry["duration_ms"]; !ok {
+		t.Error("log entry has no duration_ms")
+	}
+	if _, ok := entry["response_body"]; ok {
+		t.Error("response_body logged without debug_http")
+	}
+}
+
+func TestLoggingTransportLogsBodiesWhenEnabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"products"`) {
+			t.Errorf("server received body %q, want the collection payload", body)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "Bad field secret-admin-key."}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	c := NewServerClient("unused", "secret-admin-key", 0, "http")
+	c.baseURL = server.URL
+	c.SetDebugHTTP(true)
+
+	_, err := c.CreateCollection(ctx, &Collection{Name: "products"})
+	if err == nil || !strings.Contains(err.Error(), "Bad field") {
+		t.Fatalf("CreateCollection error = %v, want the server message (response body must still be readable)", err)
+	}
+
+	raw := logs.String()
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("failed to decode logs: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1: %v", len(entries), entries)
+	}
+
+	reqBody, _ := entries[0]["request_body"].(string)
+	respBody, _ := entries[0]["response_body"].(string)
+	if !strings.Contains(reqBody, `"name":"products"`) {
+		t.Errorf("request_body = %q, want the collection payload", reqBody)
+	}
+	if !strings.Contains(respBody, "Bad field") {
+		t.Errorf("response_body = %q, want the server message", respBody)
+	}
+	if strings.Contains(raw, "secret-admin-key") {
+		t.Error("logs contain the API key")
+	}
+}
+
+func TestDeleteLogsResourceAlreadyAbsent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	c := NewServerClient("unused", "test-api-key", 0, "http")
+	c.baseURL = server.URL
+
+	if err := c.DeleteSynonym(ctx, "products", "shoes"); err != nil {
+		t.Fatalf("DeleteSynonym returned %v, want nil for a missing synonym", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("failed to decode logs: %v", err)
+	}
+	for _, entry := range entries {
+		if strings.Contains(entry["@message"].(string), "already absent") {
+			if entry["kind"] != "synonym" || entry["id"] != "products/shoes" {
+				t.Errorf("log entry = %v, want kind synonym and id products/shoes", entry)
+			}
+			return
+		}
+	}
+	t.Errorf("no already-absent entry in logs: %v", entries)
+}
+
+func TestRedactedPathHidesAPIKeyQueryParam(t *testing.T) {
+	u, _ := url.Parse("http://localhost:8108/collections/products/documents/search?q=shoe&x-typesense-api-key=secret")
+
+	got := redactedPath(u)
+	if strings.Contains(got, "secret") {
+		t.Errorf("redactedPath() = %q, want the API key redacted", got)
+	}
+	if !strings.Contains(got, "q=shoe") {
+		t.Errorf("redactedPath() = %q, want other query params kept", got)
+	}
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// gzipMagic is the two-byte header that starts every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// readErrorBody reads a non-2xx response body for inclusion in an error
+// message. Go's transport already requests and transparently decompresses
+// gzip when it sets Accept-Encoding itself, but some proxies compress error
+// pages unconditionally (or double-encode them), which would otherwise leave
+// binary garbage in the error. Such bodies are decompressed here; anything
+// that fails to decompress is returned as-is.
+func readErrorBody(resp *http.Response) []byte {
+	body, _ := io.ReadAll(resp.Body)
+
+	encoded := strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip")
+	if !encoded && !bytes.HasPrefix(body, gzipMagic) {
+		return body
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return body
+	}
+	defer gz.Close()
+
+	decoded, err := io.ReadAll(gz)
+	if err != nil {
+		return body
+	}
+	return decoded
+}
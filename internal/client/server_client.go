@@ -1,13 +1,22 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -19,9 +28,24 @@ type ServerClient struct {
 	httpClient   *http.Client
 	apiKey       string
 	baseURL      string
-	version      string
-	versionOnce  sync.Once
-	versionMajor int
+	basePath     string
+	userAgent    string
+	extraHeaders map[string]string
+
+	// synonymSetWriteAttempts bounds retries of synonym set writes that hit
+	// concurrent modifications; zero means defaultSynonymSetWriteAttempts.
+	synonymSetWriteAttempts int
+	version                 string
+	versionMajor            int
+
+	// versionMu guards the cached version. With versionTTL zero the version
+	// is detected once and kept for the client's lifetime; a positive TTL
+	// re-detects it once the cached value is older than that.
+	versionMu        sync.Mutex
+	versionTTL       time.Duration
+	versionCheckedAt time.Time
+	versionPinned    bool
+	now              func() time.Time
 }
 
 // ServerInfo contains debug/version information from the Typesense server
@@ -76,13 +100,210 @@ func NewServerClient(host, apiKey string, port int, protocol string) *ServerClie
 	baseURL := fmt.Sprintf("%s://%s:%d", protocol, host, port)
 	return &ServerClient{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: &loggingTransport{next: http.DefaultTransport, apiKey: apiKey},
 		},
-		apiKey:  apiKey,
-		baseURL: baseURL,
+		apiKey:    apiKey,
+		baseURL:   baseURL,
+		userAgent: UserAgent("", ""),
 	}
 }
 
+// SetUserAgent sets the User-Agent header sent with every request, see
+// UserAgent. An extra header named User-Agent takes precedence.
+func (c *ServerClient) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// Host returns the host and port the client talks to, e.g. "localhost:8108".
+func (c *ServerClient) Host() string {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return c.baseURL
+	}
+	return u.Host
+}
+
+// ForHost returns a client for another node of the same cluster, e.g. one
+// entry of a Typesense Cloud cluster's node hostnames. host may carry a port;
+// without one the configured port is kept. Protocol, base path, API key and
+// headers are the same as c's, and the HTTP client is shared.
+func (c *ServerClient) ForHost(host string) (*ServerClient, error) {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse server URL: %w", err)
+	}
+	if _, _, err := net.SplitHostPort(host); err != nil && u.Port() != "" {
+		host = net.JoinHostPort(host, u.Port())
+	}
+	u.Host = host
+	return &ServerClient{
+		httpClient:              c.httpClient,
+		apiKey:                  c.apiKey,
+		baseURL:                 u.String(),
+		basePath:                c.basePath,
+		userAgent:               c.userAgent,
+		extraHeaders:            c.extraHeaders,
+		synonymSetWriteAttempts: c.synonymSetWriteAttempts,
+	}, nil
+}
+
+// SetBasePath prefixes every request path with basePath, for servers exposed
+// under a path behind a reverse proxy, e.g. "/search" for
+// https://host/search/collections. An empty basePath leaves paths as they are.
+// Call it before ConfigureCircuitBreaker, whose /health probe uses it.
+func (c *ServerClient) SetBasePath(basePath string) {
+	basePath = strings.TrimRight(basePath, "/")
+	if basePath == "" {
+		return
+	}
+	if !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	c.baseURL = strings.TrimSuffix(c.baseURL, c.basePath) + basePath
+	c.basePath = basePath
+}
+
+// SetExtraHeaders adds headers to every request, e.g. the Authorization
+// header an authenticating proxy in front of Typesense expects. They cannot
+// replace X-TYPESENSE-API-KEY or Content-Type, which are set after them; an
+// X-TYPESENSE-API-KEY entry is rejected so a second key is not silently
+// ignored.
+func (c *ServerClient) SetExtraHeaders(headers map[string]string) error {
+	for name := range headers {
+		if http.CanonicalHeaderKey(name) == http.CanonicalHeaderKey("X-TYPESENSE-API-KEY") {
+			return fmt.Errorf("%s cannot be set as an extra header; configure the server API key instead", name)
+		}
+	}
+	c.extraHeaders = headers
+	return nil
+}
+
+// defaultSynonymSetWriteAttempts is how often a synonym set write is tried
+// when no retry count is configured.
+const defaultSynonymSetWriteAttempts = 3
+
+// SetSynonymSetWriteRetries sets how often a synonym set write that lost a
+// race with another writer is retried, on top of the first attempt. A
+// negative value keeps the default.
+func (c *ServerClient) SetSynonymSetWriteRetries(retries int) {
+	if retries >= 0 {
+		c.synonymSetWriteAttempts = retries + 1
+	}
+}
+
+// SynonymSetWriteAttempts returns how often a synonym set write is tried in
+// total before giving up.
+func (c *ServerClient) SynonymSetWriteAttempts() int {
+	if c.synonymSetWriteAttempts <= 0 {
+		return defaultSynonymSetWriteAttempts
+	}
+	return c.synonymSetWriteAttempts
+}
+
+// SetDebugHTTP turns request and response body logging on or off. Method,
+// path, status and duration are always logged at debug level; bodies are
+// only logged when enabled, as they can be large.
+func (c *ServerClient) SetDebugHTTP(enabled bool) {
+	if t, ok := c.httpClient.Transport.(*loggingTransport); ok {
+		t.logBodies = enabled
+	}
+}
+
+// ConfigureTLS sets up certificate verification for HTTPS servers. When
+// caCertFile is set, the PEM certificates in it are trusted in addition to the
+// system roots, for servers behind an internal CA. insecureSkipVerify disables
+// verification entirely and is only meant for development.
+func (c *ServerClient) ConfigureTLS(caCertFile string, insecureSkipVerify bool) error {
+	if caCertFile == "" && !insecureSkipVerify {
+		return nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify, //nolint:gosec // opt-in for development servers
+	}
+
+	if caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return fmt.Errorf("failed to read CA certificate file: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no PEM certificates found in %s", caCertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	c.transport().TLSClientConfig = tlsConfig
+	return nil
+}
+
+// ConfigureHTTP2 sets the protocols used to talk to the server. By default
+// HTTP/2 is negotiated via ALPN on HTTPS and HTTP/1.1 is used otherwise. With
+// force, only HTTP/2 is used, with prior knowledge (h2c) on plain HTTP, so
+// concurrent requests such as parallel document imports share one
+// multiplexed connection; servers or proxies without HTTP/2 support then
+// fail every request.
+func (c *ServerClient) ConfigureHTTP2(force bool) {
+	transport := c.transport()
+	transport.ForceAttemptHTTP2 = true
+	if !force {
+		return
+	}
+	var protocols http.Protocols
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+	transport.Protocols = &protocols
+}
+
+// transport returns the *http.Transport that sends the client's requests,
+// replacing the shared http.DefaultTransport with a copy first so it can be
+// changed. ConfigureTLS and ConfigureHTTP2 change it in place, so they may be
+// called in either order, but both before ConfigureCircuitBreaker.
+func (c *ServerClient) transport() *http.Transport {
+	if t, ok := c.httpClient.Transport.(*loggingTransport); ok {
+		if next, ok := t.next.(*http.Transport); ok && next != http.DefaultTransport {
+			return next
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		t.next = transport
+		return transport
+	}
+	if next, ok := c.httpClient.Transport.(*http.Transport); ok && next != http.DefaultTransport {
+		return next
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	c.httpClient.Transport = transport
+	return transport
+}
+
+// ConfigureCircuitBreaker stops sending requests to a host after threshold
+// consecutive connection failures or 502/503/504 responses, until the host
+// passes a /health check once cooldown has elapsed. A threshold of zero or
+// less leaves the breaker disabled. Call it after ConfigureTLS and
+// ConfigureHTTP2, which change the underlying transport.
+func (c *ServerClient) ConfigureCircuitBreaker(threshold int, cooldown time.Duration) {
+	if threshold <= 0 {
+		return
+	}
+
+	var breaker *circuitBreakerTransport
+	if t, ok := c.httpClient.Transport.(*loggingTransport); ok {
+		breaker = newCircuitBreakerTransport(t.next, threshold, cooldown)
+		t.next = breaker
+	} else {
+		breaker = newCircuitBreakerTransport(c.httpClient.Transport, threshold, cooldown)
+		c.httpClient.Transport = breaker
+	}
+	breaker.basePath = c.basePath
+}
+
 func serverPath(baseURL string, segments ...string) string {
 	var b strings.Builder
 	b.WriteString(strings.TrimRight(baseURL, "/"))
@@ -103,8 +324,12 @@ type Collection struct {
 	EnableNestedFields  bool              `json:"enable_nested_fields,omitempty"`
 	NumDocuments        int64             `json:"num_documents,omitempty"`
 	CreatedAt           int64             `json:"created_at,omitempty"`
-	Metadata            map[string]any    `json:"metadata,omitempty"`
+	Metadata            JSONObject        `json:"metadata,omitempty"`
 	VoiceQueryModel     string            `json:"voice_query_model,omitempty"`
+	// SynonymSets and CurationSets name the v30 synonym and curation sets
+	// applied to searches on the collection.
+	SynonymSets  []string `json:"synonym_sets,omitempty"`
+	CurationSets []string `json:"curation_sets,omitempty"`
 }
 
 // CollectionField represents a field in a collection schema
@@ -112,13 +337,13 @@ type CollectionField struct {
 	Name            string           `json:"name"`
 	Type            string           `json:"type"`
 	Facet           bool             `json:"facet,omitempty"`
-	Optional        bool             `json:"optional,omitempty"`
+	Optional        *bool            `json:"optional,omitempty"`
 	Index           *bool            `json:"index,omitempty"`
 	Sort            *bool            `json:"sort,omitempty"`
 	Infix           bool             `json:"infix,omitempty"`
 	Locale          string           `json:"locale,omitempty"`
 	Drop            bool             `json:"drop,omitempty"`
-	NumDim          int64            `json:"num_dim,omitempty"`
+	NumDim          *int64           `json:"num_dim,omitempty"`
 	VecDist         string           `json:"vec_dist,omitempty"`
 	Embed           *FieldEmbed      `json:"embed,omitempty"`
 	HnswParams      *FieldHnswParams `json:"hnsw_params,omitempty"`
@@ -129,6 +354,26 @@ type CollectionField struct {
 	Store           *bool            `json:"store,omitempty"`
 	TokenSeparators []string         `json:"token_separators,omitempty"`
 	SymbolsToIndex  []string         `json:"symbols_to_index,omitempty"`
+
+	// Nested is set by the server on fields it flattened out of an object
+	// field when enable_nested_fields is on. Fields built from configuration
+	// leave it unset.
+	Nested bool `json:"nested,omitempty"`
+}
+
+// MarshalJSON encodes a dropped field as only its name and the drop flag.
+// Typesense rejects drop entries in a schema update that carry any other
+// property, including the empty type a drop built from just a name has.
+func (f CollectionField) MarshalJSON() ([]byte, error) {
+	if f.Drop {
+		return json.Marshal(struct {
+			Name string `json:"name"`
+			Drop bool   `json:"drop"`
+		}{Name: f.Name, Drop: true})
+	}
+
+	type collectionField CollectionField
+	return json.Marshal(collectionField(f))
 }
 
 // FieldEmbed represents the auto-embedding configuration for a field
@@ -142,6 +387,10 @@ type FieldModelConfig struct {
 	ModelName string `json:"model_name"`
 	APIKey    string `json:"api_key,omitempty"`
 	URL       string `json:"url,omitempty"`
+	// IndexingPrefix and QueryPrefix are prepended to the text embedded for
+	// documents and for queries, for instruction-tuned models such as e5.
+	IndexingPrefix string `json:"indexing_prefix,omitempty"`
+	QueryPrefix    string `json:"query_prefix,omitempty"`
 }
 
 // FieldHnswParams represents the HNSW algorithm tuning parameters
@@ -230,8 +479,8 @@ type CollectionAlias struct {
 
 // Preset represents a Typesense search preset
 type Preset struct {
-	Name  string         `json:"name,omitempty"`
-	Value map[string]any `json:"value"`
+	Name  string     `json:"name,omitempty"`
+	Value JSONObject `json:"value"`
 }
 
 // AnalyticsRule represents a Typesense analytics rule
@@ -264,7 +513,7 @@ func (c *ServerClient) CreateCollection(ctx context.Context, collection *Collect
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create collection: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -276,6 +525,89 @@ func (c *ServerClient) CreateCollection(ctx context.Context, collection *Collect
 	return &result, nil
 }
 
+// schemaValidationPrefix starts the names of the temporary collections
+// ValidateCollectionSchema creates.
+const schemaValidationPrefix = "tf_schema_validation_"
+
+// ValidateCollectionSchema reports whether the server accepts a collection
+// schema, without keeping a collection. Typesense has no validation endpoint,
+// so the schema is created under a temporary name and deleted right away;
+// collection.Name is ignored. The temporary name starts with namePrefix, the
+// provider's collection_name_prefix, so it stays among the collections of
+// the configured environment. A schema the server rejects (a 400 response)
+// yields the server's message and a nil error; valid schemas yield "". The
+// error is for requests that failed for other reasons, including a temporary
+// collection that could not be deleted again.
+func (c *ServerClient) ValidateCollectionSchema(ctx context.Context, collection *Collection, namePrefix string) (string, error) {
+	candidate := *collection
+	candidate.Name = namePrefix + schemaValidationPrefix + strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	body, err := json.Marshal(&candidate)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal collection: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/collections", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to create validation collection: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		bodyBytes := readErrorBody(resp)
+		var result struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &result); err == nil && result.Message != "" {
+			return result.Message, nil
+		}
+		return strings.TrimSpace(string(bodyBytes)), nil
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		return "", fmt.Errorf("failed to create validation collection: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	if err := c.DeleteCollection(ctx, candidate.Name); err != nil {
+		return "", fmt.Errorf("schema is valid, but the temporary collection %s could not be deleted: %w", candidate.Name, err)
+	}
+	return "", nil
+}
+
+// CreateCollections creates the given collections in order. If one fails, the
+// collections created before it are deleted again (best effort) so a
+// bootstrap does not leave a partial set behind. On failure the returned
+// slice holds the collections that had been created before the error; the
+// error also names any of them the rollback could not delete.
+func (c *ServerClient) CreateCollections(ctx context.Context, cols []*Collection) ([]*Collection, error) {
+	created := make([]*Collection, 0, len(cols))
+	for _, col := range cols {
+		result, err := c.CreateCollection(ctx, col)
+		if err == nil {
+			created = append(created, result)
+			continue
+		}
+
+		createErr := fmt.Errorf("failed to create collection %s: %w", col.Name, err)
+		var rollbackErrs []error
+		for i := len(created) - 1; i >= 0; i-- {
+			if delErr := c.DeleteCollection(ctx, created[i].Name); delErr != nil {
+				rollbackErrs = append(rollbackErrs, fmt.Errorf("rollback of %s: %w", created[i].Name, delErr))
+			}
+		}
+		return created, errors.Join(append([]error{createErr}, rollbackErrs...)...)
+	}
+
+	return created, nil
+}
+
 // GetCollection retrieves a collection by name
 func (c *ServerClient) GetCollection(ctx context.Context, name string) (*Collection, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, serverPath(c.baseURL, "collections", name), nil)
@@ -296,7 +628,7 @@ func (c *ServerClient) GetCollection(ctx context.Context, name string) (*Collect
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get collection: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -308,8 +640,73 @@ func (c *ServerClient) GetCollection(ctx context.Context, name string) (*Collect
 	return &result, nil
 }
 
+// GetCollectionJSON returns the collection exactly as the server describes
+// it, including attributes Collection does not model, compacted to a single
+// line. It returns nil when the collection does not exist.
+func (c *ServerClient) GetCollectionJSON(ctx context.Context, name string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, serverPath(c.baseURL, "collections", name), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to get collection: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, body); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return compact.Bytes(), nil
+}
+
 // UpdateCollection updates a collection's schema (add/drop fields)
 func (c *ServerClient) UpdateCollection(ctx context.Context, name string, update *Collection) (*Collection, error) {
+	return c.patchCollection(ctx, name, update)
+}
+
+// UpdateCollectionMetadata replaces the collection-level metadata. Unlike
+// UpdateCollection, it sends the metadata even when empty, which clears it.
+func (c *ServerClient) UpdateCollectionMetadata(ctx context.Context, name string, metadata map[string]any) (*Collection, error) {
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	return c.patchCollection(ctx, name, map[string]any{"metadata": metadata})
+}
+
+// UpdateCollectionSets replaces the synonym and curation sets associated with
+// a collection (Typesense v30.0+). Both lists are sent even when empty, which
+// removes every association.
+func (c *ServerClient) UpdateCollectionSets(ctx context.Context, name string, synonymSets, curationSets []string) (*Collection, error) {
+	if synonymSets == nil {
+		synonymSets = []string{}
+	}
+	if curationSets == nil {
+		curationSets = []string{}
+	}
+	return c.patchCollection(ctx, name, map[string]any{"synonym_sets": synonymSets, "curation_sets": curationSets})
+}
+
+func (c *ServerClient) patchCollection(ctx context.Context, name string, update any) (*Collection, error) {
 	body, err := json.Marshal(update)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal collection update: %w", err)
@@ -329,7 +726,7 @@ func (c *ServerClient) UpdateCollection(ctx context.Context, name string, update
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to update collection: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -356,14 +753,392 @@ func (c *ServerClient) DeleteCollection(ctx context.Context, name string) error
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "collection", name)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete collection: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
 	return nil
 }
 
+// bulkHTTPClient returns a client for document export/import. It shares the
+// regular transport but has no overall timeout, since copying a large
+// collection can take far longer than a schema call; ctx still bounds it.
+func (c *ServerClient) bulkHTTPClient() *http.Client {
+	return &http.Client{Transport: c.httpClient.Transport}
+}
+
+// ExportDocuments streams every document in a collection as JSONL. The caller
+// must close the returned reader.
+func (c *ServerClient) ExportDocuments(ctx context.Context, collection string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, serverPath(c.baseURL, "collections", collection, "documents", "export"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.bulkHTTPClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export documents: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to export documents: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return resp.Body, nil
+}
+
+// TruncateDocuments deletes every document of a collection, keeping the
+// collection and its schema, and returns how many were deleted. Typesense has
+// no filter_by expression that matches every document of an arbitrary schema,
+// so this uses the truncate option of the delete-by-query endpoint (v28.0+).
+// A missing collection has nothing to delete and is not an error.
+func (c *ServerClient) TruncateDocuments(ctx context.Context, collection string) (int64, error) {
+	url := serverPath(c.baseURL, "collections", collection, "documents") + "?truncate=true"
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.bulkHTTPClient().Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to truncate documents: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "collection", collection)
+		return 0, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		return 0, fmt.Errorf("failed to truncate documents: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result struct {
+		NumDeleted int64 `json:"num_deleted"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.NumDeleted, nil
+}
+
+// ImportDocuments upserts JSONL documents into a collection. Typesense answers
+// an import with one result line per document, so a 200 can still carry
+// per-document failures; those are reported as an error.
+//
+// Typesense always keys documents on "id". When idField names another field,
+// e.g. "sku", each document's id is set from that field before it is sent, so
+// re-importing a document with the same sku replaces it. An empty idField
+// sends the documents as they are.
+func (c *ServerClient) ImportDocuments(ctx context.Context, collection string, documents io.Reader, idField string) error {
+	if idField != "" && idField != "id" {
+		keyed, err := keyDocumentsByField(documents, idField)
+		if err != nil {
+			return err
+		}
+		documents = keyed
+	}
+
+	url := serverPath(c.baseURL, "collections", collection, "documents", "import") + "?action=upsert"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, documents)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+	req.Header.Set("Content-Type", "text/plain")
+
+	resp, err := c.bulkHTTPClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to import documents: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		return fmt.Errorf("failed to import documents: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var failed int
+	var firstError string
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var result struct {
+			Success bool   `json:"success"`
+			Error   string `json:"error"`
+		}
+		if err := decoder.Decode(&result); err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to decode import response: %w", err)
+		}
+		if !result.Success {
+			if failed == 0 {
+				firstError = result.Error
+			}
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to import %d document(s) into %s, first error: %s", failed, collection, firstError)
+	}
+
+	return nil
+}
+
+// CopyDocuments streams all documents from source into target. Documents are
+// upserted, so re-running a partially failed copy is safe.
+func (c *ServerClient) CopyDocuments(ctx context.Context, source, target string) error {
+	documents, err := c.ExportDocuments(ctx, source)
+	if err != nil {
+		return err
+	}
+	defer documents.Close()
+
+	return c.ImportDocuments(ctx, target, documents, "")
+}
+
+// UpsertDocument creates or replaces a single document. idField works as in
+// ImportDocuments.
+func (c *ServerClient) UpsertDocument(ctx context.Context, collection string, document map[string]any, idField string) (map[string]any, error) {
+	if idField != "" && idField != "id" {
+		id, err := documentID(document, idField)
+		if err != nil {
+			return nil, err
+		}
+		document = maps.Clone(document)
+		document["id"] = id
+	}
+
+	body, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal document: %w", err)
+	}
+
+	url := serverPath(c.baseURL, "collections", collection, "documents") + "?action=upsert"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to upsert document: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetDocument retrieves a single document by ID. It returns nil if the
+// document does not exist. Numbers are decoded as json.Number so large
+// integer IDs and counters survive being encoded again.
+func (c *ServerClient) GetDocument(ctx context.Context, collection, id string) (map[string]any, error) {
+	url := serverPath(c.baseURL, "collections", collection, "documents", id)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to get document: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result map[string]any
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// MultiSearchResult is the outcome of one search in a multi_search request.
+// A search that fails does not fail the whole request; its Error and Code are
+// set instead.
+type MultiSearchResult struct {
+	Found int64  `json:"found"`
+	Error string `json:"error,omitempty"`
+	Code  int    `json:"code,omitempty"`
+}
+
+// MultiSearch runs searches in one /multi_search request and returns their
+// results in the same order. Each search is a map of search parameters (e.g.
+// collection, q, query_by) sent as is; commonParams are sent as query
+// parameters and apply to every search that does not override them.
+func (c *ServerClient) MultiSearch(ctx context.Context, searches []map[string]any, commonParams map[string]string) ([]MultiSearchResult, error) {
+	body, err := json.Marshal(map[string]any{"searches": searches})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal searches: %w", err)
+	}
+
+	endpoint := serverPath(c.baseURL, "multi_search")
+	if len(commonParams) > 0 {
+		query := url.Values{}
+		for k, v := range commonParams {
+			query.Set(k, v)
+		}
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run multi search: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to run multi search: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result struct {
+		Results []MultiSearchResult `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(result.Results) != len(searches) {
+		return nil, fmt.Errorf("failed to run multi search: sent %d searches, got %d results", len(searches), len(result.Results))
+	}
+
+	return result.Results, nil
+}
+
+// keyDocumentsByField rewrites JSONL documents so each one's id is the value
+// of idField.
+func keyDocumentsByField(documents io.Reader, idField string) (io.Reader, error) {
+	var out bytes.Buffer
+	scanner := bufio.NewScanner(documents)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var document map[string]any
+		if err := decoder.Decode(&document); err != nil {
+			return nil, fmt.Errorf("failed to decode document on line %d: %w", line, err)
+		}
+
+		id, err := documentID(document, idField)
+		if err != nil {
+			return nil, fmt.Errorf("document on line %d: %w", line, err)
+		}
+		document["id"] = id
+
+		encoded, err := json.Marshal(document)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode document on line %d: %w", line, err)
+		}
+		out.Write(encoded)
+		out.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read documents: %w", err)
+	}
+
+	return &out, nil
+}
+
+// documentID returns the value of idField as a Typesense document id. Ids
+// are strings, so numeric keys are converted.
+func documentID(document map[string]any, idField string) (string, error) {
+	switch v := document[idField].(type) {
+	case string:
+		if v == "" {
+			return "", fmt.Errorf("id field %q is empty", idField)
+		}
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case nil:
+		return "", fmt.Errorf("missing id field %q", idField)
+	default:
+		return "", fmt.Errorf("id field %q must be a string or number, got %T", idField, v)
+	}
+}
+
+// Reindex creates target, copies every document from the source collection
+// into it and then deletes the source. If the copy fails the new collection is
+// removed again and the source is left untouched.
+func (c *ServerClient) Reindex(ctx context.Context, source string, target *Collection) (*Collection, error) {
+	created, err := c.CreateCollection(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.CopyDocuments(ctx, source, target.Name); err != nil {
+		if cleanupErr := c.DeleteCollection(ctx, target.Name); cleanupErr != nil {
+			return nil, fmt.Errorf("%w (cleaning up %s also failed: %v)", err, target.Name, cleanupErr)
+		}
+		return nil, err
+	}
+
+	if err := c.DeleteCollection(ctx, source); err != nil {
+		return nil, fmt.Errorf("documents copied to %s but %w", target.Name, err)
+	}
+
+	return created, nil
+}
+
 // CreateSynonym creates or updates a synonym
 func (c *ServerClient) CreateSynonym(ctx context.Context, collectionName string, synonym *Synonym) (*Synonym, error) {
 	body, err := json.Marshal(synonym)
@@ -386,7 +1161,7 @@ func (c *ServerClient) CreateSynonym(ctx context.Context, collectionName string,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create synonym: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -419,7 +1194,7 @@ func (c *ServerClient) GetSynonym(ctx context.Context, collectionName, synonymID
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get synonym: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -447,8 +1222,12 @@ func (c *ServerClient) DeleteSynonym(ctx context.Context, collectionName, synony
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "synonym", collectionName+"/"+synonymID)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete synonym: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -477,7 +1256,7 @@ func (c *ServerClient) CreateOverride(ctx context.Context, collectionName string
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create override: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -510,7 +1289,7 @@ func (c *ServerClient) GetOverride(ctx context.Context, collectionName, override
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get override: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -538,8 +1317,12 @@ func (c *ServerClient) DeleteOverride(ctx context.Context, collectionName, overr
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "override", collectionName+"/"+overrideID)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete override: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -568,7 +1351,7 @@ func (c *ServerClient) CreateStopwordsSet(ctx context.Context, stopwords *Stopwo
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create stopwords: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -601,7 +1384,7 @@ func (c *ServerClient) GetStopwordsSet(ctx context.Context, id string) (*Stopwor
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get stopwords: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -632,8 +1415,12 @@ func (c *ServerClient) DeleteStopwordsSet(ctx context.Context, id string) error
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "stopwords set", id)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete stopwords: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -666,7 +1453,7 @@ func (c *ServerClient) UpsertCollectionAlias(ctx context.Context, alias *Collect
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to upsert alias: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -699,7 +1486,7 @@ func (c *ServerClient) GetCollectionAlias(ctx context.Context, name string) (*Co
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get alias: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -727,8 +1514,12 @@ func (c *ServerClient) DeleteCollectionAlias(ctx context.Context, name string) e
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "collection alias", name)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete alias: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -751,7 +1542,7 @@ func (c *ServerClient) ListCollectionAliases(ctx context.Context) ([]CollectionA
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list aliases: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -791,7 +1582,7 @@ func (c *ServerClient) UpsertPreset(ctx context.Context, preset *Preset) (*Prese
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to upsert preset: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -824,7 +1615,7 @@ func (c *ServerClient) GetPreset(ctx context.Context, name string) (*Preset, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get preset: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -852,8 +1643,12 @@ func (c *ServerClient) DeletePreset(ctx context.Context, name string) error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "preset", name)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete preset: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -876,7 +1671,7 @@ func (c *ServerClient) ListPresets(ctx context.Context) ([]Preset, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list presets: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -934,7 +1729,7 @@ func (c *ServerClient) UpsertAnalyticsRule(ctx context.Context, rule *AnalyticsR
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to upsert analytics rule: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -977,6 +1772,61 @@ func (c *ServerClient) convertToLegacyParams(rule *AnalyticsRule) map[string]any
 	return legacyParams
 }
 
+// NormalizeAnalyticsRule converts a rule read from a pre-v30 server, whose
+// params nest the collection under source.collections and the target under
+// destination, into the v30 flat form: top-level collection and event_type,
+// with destination_collection and counter_field in params. Rules already in
+// the flat form are returned unchanged.
+func NormalizeAnalyticsRule(rule AnalyticsRule) AnalyticsRule {
+	source, hasSource := rule.Params["source"].(map[string]any)
+	destination, hasDestination := rule.Params["destination"].(map[string]any)
+	if !hasSource && !hasDestination {
+		return rule
+	}
+
+	params := make(map[string]any, len(rule.Params))
+	for k, v := range rule.Params {
+		if k != "source" && k != "destination" {
+			params[k] = v
+		}
+	}
+
+	if collections, ok := source["collections"].([]any); ok && len(collections) > 0 && rule.Collection == "" {
+		if coll, ok := collections[0].(string); ok {
+			rule.Collection = coll
+		}
+	}
+
+	// Counter rules list the tracked event (and its weight) under source.events.
+	if events, ok := source["events"].([]any); ok && len(events) > 0 {
+		if event, ok := events[0].(map[string]any); ok {
+			if eventType, ok := event["type"].(string); ok && rule.EventType == "" {
+				rule.EventType = eventType
+			}
+			if weight, ok := event["weight"]; ok {
+				if _, set := params["weight"]; !set {
+					params["weight"] = weight
+				}
+			}
+		}
+	}
+
+	if destColl, ok := destination["collection"].(string); ok {
+		params["destination_collection"] = destColl
+	}
+	if counterField, ok := destination["counter_field"].(string); ok {
+		params["counter_field"] = counterField
+	}
+
+	// Pre-v30 servers do not return event_type; query rules always track searches.
+	if rule.EventType == "" && (rule.Type == "popular_queries" || rule.Type == "nohits_queries") {
+		rule.EventType = "search"
+	}
+
+	rule.Params = params
+	return rule
+}
+
 // GetAnalyticsRule retrieves an analytics rule by name
 func (c *ServerClient) GetAnalyticsRule(ctx context.Context, name string) (*AnalyticsRule, error) {
 	url := serverPath(c.baseURL, "analytics", "rules", name)
@@ -998,7 +1848,7 @@ func (c *ServerClient) GetAnalyticsRule(ctx context.Context, name string) (*Anal
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get analytics rule: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1026,8 +1876,12 @@ func (c *ServerClient) DeleteAnalyticsRule(ctx context.Context, name string) err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "analytics rule", name)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete analytics rule: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1050,7 +1904,7 @@ func (c *ServerClient) ListAnalyticsRules(ctx context.Context) ([]AnalyticsRule,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list analytics rules: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1095,7 +1949,7 @@ func (c *ServerClient) CreateAPIKey(ctx context.Context, key *APIKey) (*APIKey,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create API key: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1128,7 +1982,7 @@ func (c *ServerClient) GetAPIKey(ctx context.Context, id int64) (*APIKey, error)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get API key: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1156,8 +2010,12 @@ func (c *ServerClient) DeleteAPIKey(ctx context.Context, id int64) error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "API key", strconv.FormatInt(id, 10))
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete API key: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1165,10 +2023,89 @@ func (c *ServerClient) DeleteAPIKey(ctx context.Context, id int64) error {
 }
 
 func (c *ServerClient) setHeaders(req *http.Request) {
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for name, value := range c.extraHeaders {
+		req.Header.Set(name, value)
+	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-TYPESENSE-API-KEY", c.apiKey)
 }
 
+// ServerConfig holds the runtime settings Typesense accepts on POST /config.
+// Nil fields are not sent and keep their current value on the server.
+type ServerConfig struct {
+	LogSlowRequestsTimeMs *int64 `json:"log-slow-requests-time-ms,omitempty"`
+	CacheNumEntries       *int64 `json:"cache-num-entries,omitempty"`
+	HealthyReadLag        *int64 `json:"healthy-read-lag,omitempty"`
+	HealthyWriteLag       *int64 `json:"healthy-write-lag,omitempty"`
+	SkipWrites            *bool  `json:"skip-writes,omitempty"`
+}
+
+// GetConfig reads the runtime settings. Typesense servers that do not expose
+// GET /config answer 404 or 405; GetConfig then returns nil, nil.
+func (c *ServerClient) GetConfig(ctx context.Context) (*ServerConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/config", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to get config: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result ServerConfig
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateConfig applies runtime settings. They take effect immediately but
+// are not persisted: a restarted node goes back to its command line flags or
+// configuration file.
+func (c *ServerClient) UpdateConfig(ctx context.Context, config *ServerConfig) error {
+	body, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/config", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to update config: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		bodyBytes := readErrorBody(resp)
+		return fmt.Errorf("failed to update config: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
 // GetServerInfo retrieves debug/version information from the server
 func (c *ServerClient) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/debug", nil)
@@ -1185,7 +2122,7 @@ func (c *ServerClient) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get server info: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1197,31 +2134,199 @@ func (c *ServerClient) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
 	return &result, nil
 }
 
-// GetMajorVersion returns the major version of the Typesense server (cached after first call)
-func (c *ServerClient) GetMajorVersion(ctx context.Context) int {
-	c.versionOnce.Do(func() {
-		info, err := c.GetServerInfo(ctx)
-		if err != nil || info == nil {
-			// Default to latest format if we can't determine version
-			c.versionMajor = 30
-			return
+// GetHealth reports whether the server considers itself healthy (GET /health).
+// An unhealthy node answers 503, usually with {"ok": false}; that, or an
+// {"ok": false} body with any status, is returned as false. Other statuses,
+// such as a 401 for a bad key or a 502 from a proxy, say nothing about the
+// node and are returned as an error.
+func (c *ServerClient) GetHealth(ctx context.Context) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/health", nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to get health: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK *bool `json:"ok"`
+	}
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		if resp.StatusCode == http.StatusServiceUnavailable {
+			return false, nil
 		}
-		c.version = info.Version
-		// Parse major version from string like "30.0" or "29.1.2"
-		parts := strings.Split(info.Version, ".")
-		if len(parts) > 0 {
-			major, err := strconv.Atoi(parts[0])
-			if err == nil {
-				c.versionMajor = major
-				return
-			}
+		if json.Unmarshal(bodyBytes, &result) == nil && result.OK != nil && !*result.OK {
+			return false, nil
 		}
-		// Default to latest format if parsing fails
-		c.versionMajor = 30
-	})
+		return false, fmt.Errorf("failed to get health: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.OK != nil && *result.OK, nil
+}
+
+// GetStats retrieves request rate and latency metrics (GET /stats.json).
+// The endpoint needs an admin key or a key allowed the "stats.json:list"
+// action; a 401/403 is reported as such.
+func (c *ServerClient) GetStats(ctx context.Context) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/stats.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to get stats: status %d, body: %s (the API key needs the \"stats.json:list\" action, or use an admin key)", resp.StatusCode, string(bodyBytes))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to get stats: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetMetrics retrieves CPU, memory and disk usage (GET /metrics.json). The
+// server reports every value as a string, e.g. "12.50" or "1073741824".
+// Returns nil, without an error, when the endpoint is restricted or missing
+// (401, 403 or 404), as on some hosted plans.
+func (c *ServerClient) GetMetrics(ctx context.Context) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, serverPath(c.baseURL, "metrics.json"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	c.setHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metrics: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return nil, nil
+	case http.StatusOK:
+	default:
+		bodyBytes := readErrorBody(resp)
+		return nil, fmt.Errorf("failed to get metrics: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+// SetVersionCacheTTL makes GetMajorVersion re-detect the server version once
+// the cached value is older than ttl, so a long-running process notices an
+// in-place server upgrade. A ttl of zero (the default) caches forever.
+func (c *ServerClient) SetVersionCacheTTL(ttl time.Duration) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+	c.versionTTL = ttl
+}
+
+// PinServerVersion makes GetMajorVersion return major without querying
+// /debug, for servers that block it. The TTL no longer applies.
+func (c *ServerClient) PinServerVersion(version string, major int) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+	c.version = version
+	c.versionMajor = major
+	c.versionPinned = true
+}
+
+// GetMajorVersion returns the major version of the Typesense server (cached
+// after first call, see SetVersionCacheTTL, or pinned, see PinServerVersion)
+func (c *ServerClient) GetMajorVersion(ctx context.Context) int {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	if c.versionPinned {
+		return c.versionMajor
+	}
+
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+
+	if !c.versionCheckedAt.IsZero() && (c.versionTTL <= 0 || now().Sub(c.versionCheckedAt) < c.versionTTL) {
+		return c.versionMajor
+	}
+
+	info, err := c.GetServerInfo(ctx)
+	c.cacheVersion(info, err)
 	return c.versionMajor
 }
 
+// DetectServerVersion reads the server version from /debug and caches it for
+// GetMajorVersion, so that detecting the version at provider configuration
+// and later version checks share one request.
+func (c *ServerClient) DetectServerVersion(ctx context.Context) (*ServerInfo, error) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	info, err := c.GetServerInfo(ctx)
+	c.cacheVersion(info, err)
+	return info, err
+}
+
+// cacheVersion records the result of a version detection. Callers must hold
+// versionMu.
+func (c *ServerClient) cacheVersion(info *ServerInfo, err error) {
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	c.versionCheckedAt = now()
+
+	if err != nil || info == nil {
+		// Default to latest format if we can't determine version
+		c.versionMajor = 30
+		return
+	}
+	c.version = info.Version
+	// Parse major version from string like "30.0" or "29.1.2"
+	parts := strings.Split(info.Version, ".")
+	if len(parts) > 0 {
+		major, err := strconv.Atoi(parts[0])
+		if err == nil {
+			c.versionMajor = major
+			return
+		}
+	}
+	// Default to latest format if parsing fails
+	c.versionMajor = 30
+}
+
 // ListSynonymSets retrieves all synonym sets (Typesense v30.0+)
 func (c *ServerClient) ListSynonymSets(ctx context.Context) ([]SynonymSet, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/synonym_sets", nil)
@@ -1238,12 +2343,11 @@ func (c *ServerClient) ListSynonymSets(ctx context.Context) ([]SynonymSet, error
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		// Endpoint doesn't exist, likely older Typesense version
-		return nil, nil
+		return nil, newVersionUnsupportedError("synonym_sets")
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list synonym sets: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1276,7 +2380,7 @@ func (c *ServerClient) GetSynonymSet(ctx context.Context, name string) (*Synonym
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get synonym set: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1309,8 +2413,17 @@ func (c *ServerClient) UpsertSynonymSet(ctx context.Context, synonymSet *Synonym
 	}
 	defer resp.Body.Close()
 
+	// A PUT creates the set, so a 404 means the endpoint itself is missing.
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, newVersionUnsupportedError("synonym_sets")
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		return nil, &ConflictError{Resource: "synonym set " + synonymSet.Name, Body: string(readErrorBody(resp))}
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to upsert synonym set: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1338,8 +2451,12 @@ func (c *ServerClient) DeleteSynonymSet(ctx context.Context, name string) error
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "synonym set", name)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete synonym set: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1348,6 +2465,12 @@ func (c *ServerClient) DeleteSynonymSet(ctx context.Context, name string) error
 
 // EnsureSynonymSetExists creates a synonym set if it doesn't already exist (Typesense v30.0+).
 // Uses GET to check existence, and only creates with empty items if the set is missing.
+//
+// Creating the set is a whole-set PUT, which would wipe items another process
+// added since the first check. It goes through MergeSynonymSetItems, which
+// re-reads the set right before the PUT and again after a conflict; callers
+// that need certainty should confirm their item afterwards (see the synonym
+// resource).
 func (c *ServerClient) EnsureSynonymSetExists(ctx context.Context, name string) error {
 	existing, err := c.GetSynonymSet(ctx, name)
 	if err != nil {
@@ -1355,10 +2478,7 @@ func (c *ServerClient) EnsureSynonymSetExists(ctx context.Context, name string)
 	}
 
 	if existing == nil {
-		// Create with empty items - this is safe because the set doesn't exist yet
-		emptySet := &SynonymSet{Name: name, Synonyms: []SynonymItem{}}
-		_, err = c.UpsertSynonymSet(ctx, emptySet)
-		if err != nil {
+		if err := c.MergeSynonymSetItems(ctx, name, nil); err != nil {
 			return fmt.Errorf("failed to create synonym set: %w", err)
 		}
 	}
@@ -1366,6 +2486,50 @@ func (c *ServerClient) EnsureSynonymSetExists(ctx context.Context, name string)
 	return nil
 }
 
+// MergeSynonymSetItems writes items into the synonym set name with a
+// whole-set PUT, keeping the items already in the set (Typesense v30.0+).
+// Items with the same id are replaced. The set is read right before each PUT,
+// and when the server reports a conflict the read, merge and PUT are repeated,
+// up to SynonymSetWriteAttempts times. Nothing is written when the set exists
+// and already holds the items.
+func (c *ServerClient) MergeSynonymSetItems(ctx context.Context, name string, items []SynonymItem) error {
+	attempts := c.SynonymSetWriteAttempts()
+	for attempt := 1; ; attempt++ {
+		existing, err := c.GetSynonymSet(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed to read synonym set: %w", err)
+		}
+
+		merged := &SynonymSet{Name: name, Synonyms: []SynonymItem{}}
+		if existing != nil {
+			merged.Synonyms = append(merged.Synonyms, existing.Synonyms...)
+		}
+		changed := existing == nil
+		for _, item := range items {
+			index := slices.IndexFunc(merged.Synonyms, func(s SynonymItem) bool { return s.ID == item.ID })
+			switch {
+			case index < 0:
+				merged.Synonyms = append(merged.Synonyms, item)
+				changed = true
+			case !reflect.DeepEqual(merged.Synonyms[index], item):
+				merged.Synonyms[index] = item
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+
+		_, err = c.UpsertSynonymSet(ctx, merged)
+		if err == nil {
+			return nil
+		}
+		if !IsConflict(err) || attempt >= attempts {
+			return err
+		}
+	}
+}
+
 // UpsertSynonymSetItem creates or updates a single synonym item within a set (Typesense v30.0+)
 func (c *ServerClient) UpsertSynonymSetItem(ctx context.Context, setName string, item *SynonymItem) (*SynonymItem, error) {
 	body, err := json.Marshal(item)
@@ -1392,7 +2556,7 @@ func (c *ServerClient) UpsertSynonymSetItem(ctx context.Context, setName string,
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to upsert synonym item: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1425,7 +2589,7 @@ func (c *ServerClient) GetSynonymSetItem(ctx context.Context, setName, itemID st
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get synonym item: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1453,8 +2617,12 @@ func (c *ServerClient) DeleteSynonymSetItem(ctx context.Context, setName, itemID
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "synonym set item", setName+"/"+itemID)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete synonym item: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1477,12 +2645,11 @@ func (c *ServerClient) ListCurationSets(ctx context.Context) ([]CurationSet, err
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		// Endpoint doesn't exist, likely older Typesense version
-		return nil, nil
+		return nil, newVersionUnsupportedError("curation_sets")
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list curation sets: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1515,7 +2682,7 @@ func (c *ServerClient) GetCurationSet(ctx context.Context, name string) (*Curati
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get curation set: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1548,8 +2715,13 @@ func (c *ServerClient) UpsertCurationSet(ctx context.Context, curationSet *Curat
 	}
 	defer resp.Body.Close()
 
+	// A PUT creates the set, so a 404 means the endpoint itself is missing.
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, newVersionUnsupportedError("curation_sets")
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to upsert curation set: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1577,8 +2749,12 @@ func (c *ServerClient) DeleteCurationSet(ctx context.Context, name string) error
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "curation set", name)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete curation set: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1629,7 +2805,7 @@ func (c *ServerClient) UpsertCurationSetItem(ctx context.Context, setName string
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to upsert curation item: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1662,7 +2838,7 @@ func (c *ServerClient) GetCurationSetItem(ctx context.Context, setName, itemID s
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get curation item: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1690,8 +2866,12 @@ func (c *ServerClient) DeleteCurationSetItem(ctx context.Context, setName, itemI
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "curation set item", setName+"/"+itemID)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete curation item: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1714,7 +2894,7 @@ func (c *ServerClient) ListCollections(ctx context.Context) ([]Collection, error
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list collections: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1726,84 +2906,213 @@ func (c *ServerClient) ListCollections(ctx context.Context) ([]Collection, error
 	return result, nil
 }
 
-// ListSynonyms retrieves all synonyms for a collection (Typesense v29 and earlier)
-// For Typesense v30+, this endpoint doesn't exist - use ListSynonymSets instead.
-// Returns an empty list if the endpoint doesn't exist (404).
-func (c *ServerClient) ListSynonyms(ctx context.Context, collectionName string) ([]Synonym, error) {
-	url := serverPath(c.baseURL, "collections", collectionName, "synonyms")
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+// listPageSize is the number of synonyms or overrides requested per page.
+const listPageSize = 250
+
+// getListPage fetches one page of a per-collection synonym or override list
+// into out. It returns false when the endpoint does not exist (404).
+func (c *ServerClient) getListPage(ctx context.Context, endpoint, what string, offset int, out any) (bool, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(listPageSize))
+	query.Set("offset", strconv.Itoa(offset))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return false, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	c.setHeaders(req)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list synonyms: %w", err)
+		return false, fmt.Errorf("failed to list %s: %w", what, err)
 	}
 	defer resp.Body.Close()
 
-	// In Typesense 30.0+, the per-collection synonyms endpoint no longer exists
-	// Return empty list instead of error to allow graceful fallback
 	if resp.StatusCode == http.StatusNotFound {
-		return []Synonym{}, nil
+		return false, nil
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list synonyms: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+		bodyBytes := readErrorBody(resp)
+		return false, fmt.Errorf("failed to list %s: status %d, body: %s", what, resp.StatusCode, string(bodyBytes))
 	}
 
-	// The API returns {"synonyms": [...]}
-	var wrapper struct {
-		Synonyms []Synonym `json:"synonyms"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&wrapper); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
 	}
+	return true, nil
+}
 
-	return wrapper.Synonyms, nil
+// ListSynonyms retrieves all synonyms for a collection (Typesense v29 and earlier)
+// For Typesense v30+, this endpoint doesn't exist - use ListSynonymSets instead.
+// Returns an empty list if the endpoint doesn't exist (404).
+//
+// Synonyms are fetched listPageSize at a time with limit and offset. Paging
+// stops at a short page, or at one with no new ids, which is what a server
+// that ignores limit returns.
+func (c *ServerClient) ListSynonyms(ctx context.Context, collectionName string) ([]Synonym, error) {
+	endpoint := serverPath(c.baseURL, "collections", collectionName, "synonyms")
+
+	synonyms := []Synonym{}
+	seen := make(map[string]bool)
+	for offset := 0; ; offset += listPageSize {
+		// The API returns {"synonyms": [...]}
+		var page struct {
+			Synonyms []Synonym `json:"synonyms"`
+		}
+		// In Typesense 30.0+, the per-collection synonyms endpoint no longer
+		// exists. Return what was found instead of an error to allow graceful
+		// fallback.
+		found, err := c.getListPage(ctx, endpoint, "synonyms", offset, &page)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return synonyms, nil
+		}
+
+		added := 0
+		for _, synonym := range page.Synonyms {
+			if !seen[synonym.ID] {
+				seen[synonym.ID] = true
+				synonyms = append(synonyms, synonym)
+				added++
+			}
+		}
+		if len(page.Synonyms) < listPageSize || added == 0 {
+			return synonyms, nil
+		}
+	}
 }
 
 // ListOverrides retrieves all overrides for a collection (Typesense v29 and earlier)
 // For Typesense v30+, this endpoint doesn't exist - use ListCurationSets instead.
 // Returns an empty list if the endpoint doesn't exist (404).
+//
+// Overrides are paged the same way as in ListSynonyms.
 func (c *ServerClient) ListOverrides(ctx context.Context, collectionName string) ([]Override, error) {
-	url := serverPath(c.baseURL, "collections", collectionName, "overrides")
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	c.setHeaders(req)
+	endpoint := serverPath(c.baseURL, "collections", collectionName, "overrides")
+
+	overrides := []Override{}
+	seen := make(map[string]bool)
+	for offset := 0; ; offset += listPageSize {
+		// The API returns {"overrides": [...]}
+		var page struct {
+			Overrides []Override `json:"overrides"`
+		}
+		// In Typesense 30.0+, the per-collection overrides endpoint no longer
+		// exists. Return what was found instead of an error to allow graceful
+		// fallback.
+		found, err := c.getListPage(ctx, endpoint, "overrides", offset, &page)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return overrides, nil
+		}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list overrides: %w", err)
+		added := 0
+		for _, override := range page.Overrides {
+			if !seen[override.ID] {
+				seen[override.ID] = true
+				overrides = append(overrides, override)
+				added++
+			}
+		}
+		if len(page.Overrides) < listPageSize || added == 0 {
+			return overrides, nil
+		}
 	}
-	defer resp.Body.Close()
+}
 
-	// In Typesense 30.0+, the per-collection overrides endpoint no longer exists
-	// Return empty list instead of error to allow graceful fallback
-	if resp.StatusCode == http.StatusNotFound {
-		return []Override{}, nil
+// MigrateCollectionCurations copies a collection's per-collection synonyms and
+// overrides (Typesense v29 and earlier) into the v30 synonym set and curation
+// set named after the collection. Items are written one at a time so entries
+// already present in the sets are kept; re-running the migration is safe.
+func (c *ServerClient) MigrateCollectionCurations(ctx context.Context, collection string) error {
+	synonyms, err := c.ListSynonyms(ctx, collection)
+	if err != nil {
+		return fmt.Errorf("failed to read synonyms for %s: %w", collection, err)
 	}
-
-	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list overrides: status %d, body: %s", resp.StatusCode, string(bodyBytes))
+	if len(synonyms) > 0 {
+		if err := c.EnsureSynonymSetExists(ctx, collection); err != nil {
+			return err
+		}
+		for _, syn := range synonyms {
+			item := SynonymItem{ID: syn.ID, Root: syn.Root, Synonyms: syn.Synonyms}
+			if _, err := c.UpsertSynonymSetItem(ctx, collection, &item); err != nil {
+				return fmt.Errorf("failed to migrate synonym %s: %w", syn.ID, err)
+			}
+		}
 	}
 
-	// The API returns {"overrides": [...]}
-	var wrapper struct {
-		Overrides []Override `json:"overrides"`
+	overrides, err := c.ListOverrides(ctx, collection)
+	if err != nil {
+		return fmt.Errorf("failed to read overrides for %s: %w", collection, err)
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&wrapper); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if len(overrides) > 0 {
+		if err := c.EnsureCurationSetExists(ctx, collection); err != nil {
+			return err
+		}
+		for _, ovr := range overrides {
+			item := overrideToCurationItem(&ovr)
+			if _, err := c.UpsertCurationSetItem(ctx, collection, &item); err != nil {
+				return fmt.Errorf("failed to migrate override %s: %w", ovr.ID, err)
+			}
+		}
 	}
 
-	return wrapper.Overrides, nil
+	return nil
+}
+
+// overrideToCurationItem converts a v29 override into a v30 curation item.
+// remove_matched_tokens is sent explicitly because the v30 server defaults it
+// to true, except alongside replace_query where v30 rejects the pair.
+func overrideToCurationItem(o *Override) CurationItem {
+	ci := CurationItem{
+		ID:                o.ID,
+		Rule:              o.Rule,
+		Includes:          o.Includes,
+		Excludes:          o.Excludes,
+		FilterBy:          o.FilterBy,
+		SortBy:            o.SortBy,
+		ReplaceQuery:      o.ReplaceQuery,
+		FilterCuratedHits: o.FilterCuratedHits,
+		EffectiveFromTs:   o.EffectiveFromTs,
+		EffectiveToTs:     o.EffectiveToTs,
+		StopProcessing:    o.StopProcessing,
+		Metadata:          o.Metadata,
+	}
+	if !(o.ReplaceQuery != "" && o.RemoveMatchedTokens) {
+		rmt := o.RemoveMatchedTokens
+		ci.RemoveMatchedTokens = &rmt
+	}
+	return ci
+}
+
+// CurationItemToOverride converts a v30 curation item into a v29 override. A
+// nil RemoveMatchedTokens means the server stored no value; it is read as
+// false so the result compares equal to an override read from v29.
+func CurationItemToOverride(c *CurationItem) *Override {
+	rmt := false
+	if c.RemoveMatchedTokens != nil {
+		rmt = *c.RemoveMatchedTokens
+	}
+	return &Override{
+		ID:                  c.ID,
+		Rule:                c.Rule,
+		Includes:            c.Includes,
+		Excludes:            c.Excludes,
+		FilterBy:            c.FilterBy,
+		SortBy:              c.SortBy,
+		ReplaceQuery:        c.ReplaceQuery,
+		RemoveMatchedTokens: rmt,
+		FilterCuratedHits:   c.FilterCuratedHits,
+		EffectiveFromTs:     c.EffectiveFromTs,
+		EffectiveToTs:       c.EffectiveToTs,
+		StopProcessing:      c.StopProcessing,
+	}
 }
 
 // ListStopwordsSets retrieves all stopwords sets
@@ -1822,7 +3131,7 @@ func (c *ServerClient) ListStopwordsSets(ctx context.Context) ([]StopwordsSet, e
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list stopwords: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1869,7 +3178,7 @@ func (c *ServerClient) UpsertStemmingDictionary(ctx context.Context, id string,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to upsert stemming dictionary: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1901,7 +3210,7 @@ func (c *ServerClient) GetStemmingDictionary(ctx context.Context, id string) (*S
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get stemming dictionary: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1933,8 +3242,12 @@ func (c *ServerClient) DeleteStemmingDictionary(ctx context.Context, id string)
 
 	// Accept 200 OK, 404 Not Found (already deleted), and 405 Method Not Allowed
 	// (endpoint may not support DELETE - gracefully remove from state only)
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "stemming dictionary", id)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusMethodNotAllowed {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete stemming dictionary: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -1957,7 +3270,7 @@ func (c *ServerClient) ListStemmingDictionaries(ctx context.Context) ([]Stemming
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list stemming dictionaries: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2004,7 +3317,7 @@ func (c *ServerClient) ListStemmingDictionaries(ctx context.Context) ([]Stemming
 
 // NLSearchModel represents a Typesense Natural Language Search Model configuration
 type NLSearchModel struct {
-	ID            string   `json:"id"`
+	ID            string   `json:"id,omitempty"` // server-generated when empty on create
 	ModelName     string   `json:"model_name"`
 	APIKey        string   `json:"api_key,omitempty"`
 	SystemPrompt  string   `json:"system_prompt,omitempty"`
@@ -2024,7 +3337,8 @@ type NLSearchModel struct {
 	APIVersion    string   `json:"api_version,omitempty"`    // Google API version
 }
 
-// CreateNLSearchModel creates a new Natural Language Search Model
+// CreateNLSearchModel creates a new Natural Language Search Model. When
+// model.ID is empty the server generates one; it is returned in the result.
 func (c *ServerClient) CreateNLSearchModel(ctx context.Context, model *NLSearchModel) (*NLSearchModel, error) {
 	body, err := json.Marshal(model)
 	if err != nil {
@@ -2049,8 +3363,12 @@ func (c *ServerClient) CreateNLSearchModel(ctx context.Context, model *NLSearchM
 		return c.UpdateNLSearchModel(ctx, model)
 	}
 
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, newVersionUnsupportedError("nl_search_models")
+	}
+
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create NL search model: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2059,6 +3377,13 @@ func (c *ServerClient) CreateNLSearchModel(ctx context.Context, model *NLSearchM
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	if result.ID == "" {
+		if model.ID == "" {
+			return nil, fmt.Errorf("failed to create NL search model: server response did not include the generated id")
+		}
+		result.ID = model.ID
+	}
+
 	return &result, nil
 }
 
@@ -2083,7 +3408,7 @@ func (c *ServerClient) GetNLSearchModel(ctx context.Context, id string) (*NLSear
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get NL search model: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2117,7 +3442,7 @@ func (c *ServerClient) UpdateNLSearchModel(ctx context.Context, model *NLSearchM
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to update NL search model: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2145,8 +3470,12 @@ func (c *ServerClient) DeleteNLSearchModel(ctx context.Context, id string) error
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "NL search model", id)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete NL search model: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2192,7 +3521,7 @@ func (c *ServerClient) CreateConversationModel(ctx context.Context, model *Conve
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to create conversation model: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2225,7 +3554,7 @@ func (c *ServerClient) GetConversationModel(ctx context.Context, id string) (*Co
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to get conversation model: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2259,7 +3588,7 @@ func (c *ServerClient) UpdateConversationModel(ctx context.Context, model *Conve
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to update conversation model: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2287,8 +3616,12 @@ func (c *ServerClient) DeleteConversationModel(ctx context.Context, id string) e
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		logAlreadyAbsent(ctx, "conversation model", id)
+	}
+
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return fmt.Errorf("failed to delete conversation model: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2311,7 +3644,7 @@ func (c *ServerClient) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list API keys: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2342,11 +3675,11 @@ func (c *ServerClient) ListNLSearchModels(ctx context.Context) ([]NLSearchModel,
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
+		return nil, newVersionUnsupportedError("nl_search_models")
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list NL search models: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
@@ -2378,7 +3711,7 @@ func (c *ServerClient) ListConversationModels(ctx context.Context) ([]Conversati
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
+		bodyBytes := readErrorBody(resp)
 		return nil, fmt.Errorf("failed to list conversation models: status %d, body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
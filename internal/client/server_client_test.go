@@ -1,13 +1,29 @@
 package client
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // =============================================================================
@@ -234,11 +250,10 @@ func TestCollectionJSONSerialization(t *testing.T) {
 		EnableNestedFields:  true,
 		Fields: []CollectionField{
 			{
-				Name:     "title",
-				Type:     "string",
-				Facet:    true,
-				Optional: false,
-				Index:    &indexTrue,
+				Name:  "title",
+				Type:  "string",
+				Facet: true,
+				Index: &indexTrue,
 			},
 			{
 				Name:  "price",
@@ -527,6 +542,31 @@ func TestAPIKeyJSONSerialization(t *testing.T) {
 // CurationItem (within CurationSet) API Payload Tests
 // =============================================================================
 
+func TestCurationItemToOverride(t *testing.T) {
+	rmt := true
+	item := CurationItem{
+		ID:                  "promote-sale-items",
+		Rule:                OverrideRule{Query: "sale", Match: "exact"},
+		Includes:            []OverrideInclude{{ID: "item-1", Position: 1}},
+		FilterBy:            "in_stock:true",
+		RemoveMatchedTokens: &rmt,
+		StopProcessing:      true,
+	}
+
+	override := CurationItemToOverride(&item)
+	if override.ID != item.ID || override.Rule.Query != "sale" || len(override.Includes) != 1 || override.FilterBy != "in_stock:true" {
+		t.Errorf("unexpected override %+v", override)
+	}
+	if !override.RemoveMatchedTokens || !override.StopProcessing {
+		t.Errorf("expected remove_matched_tokens and stop_processing to be carried over, got %+v", override)
+	}
+
+	item.RemoveMatchedTokens = nil
+	if CurationItemToOverride(&item).RemoveMatchedTokens {
+		t.Error("expected an unset remove_matched_tokens to read as false")
+	}
+}
+
 func TestCurationItemJSONSerialization(t *testing.T) {
 	rmt := false
 	item := CurationItem{
@@ -1505,3 +1545,1553 @@ func TestOverrideRoundTrip(t *testing.T) {
 		t.Errorf("StopProcessing mismatch: got %v, want %v", decoded.StopProcessing, original.StopProcessing)
 	}
 }
+
+// TestGzippedErrorBodyIsReadable verifies that an error page compressed by a
+// proxy (without the client asking for it) is decompressed before it is
+// embedded in the returned error.
+func TestGzippedErrorBodyIsReadable(t *testing.T) {
+	const message = `{"message": "Collection schema is invalid."}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		_, _ = gz.Write([]byte(message))
+		_ = gz.Close()
+
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(buf.Bytes())
+	}))
+	defer server.Close()
+
+	// DisableCompression stops the transport from negotiating (and
+	// transparently decoding) gzip, mimicking a proxy that compresses
+	// responses the client never asked to be compressed.
+	client := &ServerClient{
+		httpClient: &http.Client{Transport: &http.Transport{DisableCompression: true}},
+		apiKey:     "test-api-key",
+		baseURL:    server.URL,
+	}
+
+	_, err := client.CreateCollection(context.Background(), &Collection{Name: "broken"})
+	if err == nil {
+		t.Fatal("CreateCollection succeeded, want error")
+	}
+	if strings.Contains(err.Error(), string([]byte{0x1f, 0x8b})) {
+		t.Fatalf("error contains raw gzip bytes: %q", err.Error())
+	}
+	if !strings.Contains(err.Error(), message) {
+		t.Errorf("error = %q, want it to contain decompressed body %q", err.Error(), message)
+	}
+}
+
+func TestMigrateCollectionCurationsWritesV30Sets(t *testing.T) {
+	type write struct {
+		path string
+		body map[string]any
+	}
+	var writes []write
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/products/synonyms":
+			_, _ = w.Write([]byte(`{"synonyms": [{"id": "shoes", "synonyms": ["sneakers", "trainers"]}, {"id": "phone", "root": "phone", "synonyms": ["mobile"]}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/products/overrides":
+			_, _ = w.Write([]byte(`{"overrides": [{"id": "pin-apple", "rule": {"query": "apple", "match": "exact"}, "includes": [{"id": "42", "position": 1}], "remove_matched_tokens": true}]}`))
+		case r.Method == http.MethodGet:
+			// Neither v30 set exists yet.
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPut:
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode %s body: %v", r.URL.Path, err)
+			}
+			writes = append(writes, write{path: r.URL.Path, body: body})
+			_ = json.NewEncoder(w).Encode(body)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := &ServerClient{
+		httpClient: server.Client(),
+		apiKey:     "test-api-key",
+		baseURL:    server.URL,
+	}
+
+	if err := client.MigrateCollectionCurations(context.Background(), "products"); err != nil {
+		t.Fatalf("MigrateCollectionCurations returned %v", err)
+	}
+
+	wantPaths := []string{
+		"/synonym_sets/products",
+		"/synonym_sets/products/items/shoes",
+		"/synonym_sets/products/items/phone",
+		"/curation_sets/products",
+		"/curation_sets/products/items/pin-apple",
+	}
+	if len(writes) != len(wantPaths) {
+		t.Fatalf("got %d writes, want %d: %+v", len(writes), len(wantPaths), writes)
+	}
+	for i, want := range wantPaths {
+		if writes[i].path != want {
+			t.Errorf("write %d path = %q, want %q", i, writes[i].path, want)
+		}
+	}
+
+	if root := writes[2].body["root"]; root != "phone" {
+		t.Errorf("phone synonym root = %v, want %q", root, "phone")
+	}
+	curation := writes[4].body
+	rule, _ := curation["rule"].(map[string]any)
+	if rule["query"] != "apple" || rule["match"] != "exact" {
+		t.Errorf("curation rule = %v, want query=apple match=exact", rule)
+	}
+	if curation["remove_matched_tokens"] != true {
+		t.Errorf("remove_matched_tokens = %v, want true", curation["remove_matched_tokens"])
+	}
+}
+
+func TestMigrateCollectionCurationsSkipsEmptyCollections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/collections/empty/synonyms":
+			_, _ = w.Write([]byte(`{"synonyms": []}`))
+		case "/collections/empty/overrides":
+			_, _ = w.Write([]byte(`{"overrides": []}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := &ServerClient{
+		httpClient: server.Client(),
+		apiKey:     "test-api-key",
+		baseURL:    server.URL,
+	}
+
+	if err := client.MigrateCollectionCurations(context.Background(), "empty"); err != nil {
+		t.Fatalf("MigrateCollectionCurations returned %v", err)
+	}
+}
+
+func TestGetMajorVersionRefreshesAfterTTL(t *testing.T) {
+	serverVersion := "29.0"
+	debugCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/debug" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		debugCalls++
+		_, _ = w.Write([]byte(`{"state": 1, "version": "` + serverVersion + `"}`))
+	}))
+	defer server.Close()
+
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := &ServerClient{
+		httpClient: server.Client(),
+		apiKey:     "test-api-key",
+		baseURL:    server.URL,
+		now:        func() time.Time { return clock },
+	}
+	client.SetVersionCacheTTL(time.Minute)
+	ctx := context.Background()
+
+	if got := client.GetMajorVersion(ctx); got != 29 {
+		t.Fatalf("GetMajorVersion() = %d, want 29", got)
+	}
+
+	// The server is upgraded in place; within the TTL the cached value holds.
+	serverVersion = "30.0"
+	clock = clock.Add(30 * time.Second)
+	if got := client.GetMajorVersion(ctx); got != 29 {
+		t.Fatalf("GetMajorVersion() within TTL = %d, want cached 29", got)
+	}
+
+	clock = clock.Add(31 * time.Second)
+	if got := client.GetMajorVersion(ctx); got != 30 {
+		t.Fatalf("GetMajorVersion() after TTL = %d, want 30", got)
+	}
+	if debugCalls != 2 {
+		t.Errorf("/debug called %d times, want 2", debugCalls)
+	}
+}
+
+func TestGetMajorVersionCachesForeverByDefault(t *testing.T) {
+	serverVersion := "29.0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"state": 1, "version": "` + serverVersion + `"}`))
+	}))
+	defer server.Close()
+
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := &ServerClient{
+		httpClient: server.Client(),
+		apiKey:     "test-api-key",
+		baseURL:    server.URL,
+		now:        func() time.Time { return clock },
+	}
+	ctx := context.Background()
+
+	if got := client.GetMajorVersion(ctx); got != 29 {
+		t.Fatalf("GetMajorVersion() = %d, want 29", got)
+	}
+
+	serverVersion = "30.0"
+	clock = clock.Add(24 * time.Hour)
+	if got := client.GetMajorVersion(ctx); got != 29 {
+		t.Errorf("GetMajorVersion() without TTL = %d, want cached 29", got)
+	}
+}
+
+func TestGetMajorVersionPinnedSkipsDebug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+	client.SetVersionCacheTTL(time.Nanosecond)
+	client.PinServerVersion("29.0", 29)
+
+	if got := client.GetMajorVersion(context.Background()); got != 29 {
+		t.Errorf("GetMajorVersion() = %d, want the pinned 29", got)
+	}
+}
+
+func TestGetStatsDecodesMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/stats.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{
+			"latency_ms": {"GET /collections/products/documents/search": 2.5},
+			"requests_per_second": {"GET /collections/products/documents/search": 12.3},
+			"search_latency_ms": 2.5,
+			"search_requests_per_second": 12.3,
+			"total_requests_per_second": 14.1,
+			"write_latency_ms": 0,
+			"write_requests_per_second": 1.8,
+			"pending_write_batches": 0
+		}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	stats, err := client.GetStats(context.Background())
+	if err != nil {
+		t.Fatalf("GetStats returned %v", err)
+	}
+	if got := stats["total_requests_per_second"]; got != 14.1 {
+		t.Errorf("total_requests_per_second = %v, want 14.1", got)
+	}
+	if got := stats["search_latency_ms"]; got != 2.5 {
+		t.Errorf("search_latency_ms = %v, want 2.5", got)
+	}
+}
+
+func TestGetMetricsDecodesGauges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{
+			"system_cpu_active_percentage": "12.50",
+			"system_memory_used_bytes": "1073741824",
+			"typesense_memory_active_bytes": "52428800"
+		}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	metrics, err := client.GetMetrics(context.Background())
+	if err != nil {
+		t.Fatalf("GetMetrics returned %v", err)
+	}
+	if got := metrics["system_cpu_active_percentage"]; got != "12.50" {
+		t.Errorf("system_cpu_active_percentage = %v, want \"12.50\"", got)
+	}
+}
+
+func TestGetMetricsReturnsNilWhenRestricted(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+
+		client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+		metrics, err := client.GetMetrics(context.Background())
+		if err != nil || metrics != nil {
+			t.Errorf("status %d: GetMetrics() = %v, %v, want nil, nil", status, metrics, err)
+		}
+		server.Close()
+	}
+}
+
+func TestGetStatsExplainsMissingPermission(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message": "Forbidden - a valid x-typesense-api-key header must be sent."}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "search-only-key", baseURL: server.URL}
+
+	_, err := client.GetStats(context.Background())
+	if err == nil {
+		t.Fatal("GetStats succeeded, want error")
+	}
+	if !strings.Contains(err.Error(), "stats.json:list") {
+		t.Errorf("error = %q, want it to name the stats.json:list action", err.Error())
+	}
+}
+
+func TestGetHealthReportsUnhealthyNode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"ok": false}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	healthy, err := client.GetHealth(context.Background())
+	if err != nil {
+		t.Fatalf("GetHealth returned %v", err)
+	}
+	if healthy {
+		t.Error("GetHealth() = true, want false for a 503 {\"ok\": false} response")
+	}
+}
+
+func TestGetHealthStatuses(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantHealthy bool
+		wantErr     bool
+	}{
+		{name: "healthy", status: http.StatusOK, body: `{"ok": true}`, wantHealthy: true},
+		{name: "503 without body", status: http.StatusServiceUnavailable},
+		{name: "ok false with another status", status: http.StatusInternalServerError, body: `{"ok": false}`},
+		// A proxy in front of the server answers with its own status and body.
+		{name: "proxy error", status: http.StatusBadGateway, body: `<html>502 Bad Gateway</html>`, wantErr: true},
+		{name: "bad key", status: http.StatusUnauthorized, body: `{"message": "Forbidden - a valid x-typesense-api-key header must be sent."}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+			healthy, err := client.GetHealth(context.Background())
+			if (err != nil) != tt.wantErr || healthy != tt.wantHealthy {
+				t.Errorf("GetHealth() = %v, %v; want %v, error %v", healthy, err, tt.wantHealthy, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestForHostAddressesOtherNode(t *testing.T) {
+	lb := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to the configured host: %s %s", r.Method, r.URL.Path)
+	}))
+	defer lb.Close()
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/debug" {
+			t.Errorf("Expected /search/debug, got %s", r.URL.Path)
+		}
+		if got := r.Header.Get("X-TYPESENSE-API-KEY"); got != "test-api-key" {
+			t.Errorf("API key = %q, want test-api-key", got)
+		}
+		_, _ = w.Write([]byte(`{"state": 4, "version": "29.0"}`))
+	}))
+	defer node.Close()
+
+	client := &ServerClient{httpClient: lb.Client(), apiKey: "test-api-key", baseURL: lb.URL}
+	client.SetBasePath("/search")
+
+	nodeClient, err := client.ForHost(strings.TrimPrefix(node.URL, "http://"))
+	if err != nil {
+		t.Fatalf("ForHost returned %v", err)
+	}
+	info, err := nodeClient.GetServerInfo(context.Background())
+	if err != nil {
+		t.Fatalf("GetServerInfo returned %v", err)
+	}
+	if info.State != 4 || info.Version != "29.0" {
+		t.Errorf("server info = %+v, want the node's follower state and version", info)
+	}
+
+	// Without a port, the configured one is kept.
+	nodeClient, err = client.ForHost("node-1.example.com")
+	if err != nil {
+		t.Fatalf("ForHost returned %v", err)
+	}
+	_, port, _ := net.SplitHostPort(client.Host())
+	if want := "node-1.example.com:" + port; nodeClient.Host() != want {
+		t.Errorf("Host() = %s, want %s", nodeClient.Host(), want)
+	}
+}
+
+func TestReindexCopiesDocumentsAndDeletesSource(t *testing.T) {
+	const docs = `{"id":"1","title":"a"}` + "\n" + `{"id":"2","title":"b"}`
+	var calls []string
+	var imported string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/collections":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"name": "products_v2", "fields": []}`))
+		case r.URL.Path == "/collections/products/documents/export":
+			_, _ = w.Write([]byte(docs))
+		case r.URL.Path == "/collections/products_v2/documents/import":
+			if got := r.URL.Query().Get("action"); got != "upsert" {
+				t.Errorf("import action = %q, want upsert", got)
+			}
+			body, _ := io.ReadAll(r.Body)
+			imported = string(body)
+			_, _ = w.Write([]byte(`{"success":true}` + "\n" + `{"success":true}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/collections/products":
+			_, _ = w.Write([]byte(`{"name": "products"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	if _, err := client.Reindex(context.Background(), "products", &Collection{Name: "products_v2"}); err != nil {
+		t.Fatalf("Reindex returned %v", err)
+	}
+
+	if imported != docs {
+		t.Errorf("imported %q, want %q", imported, docs)
+	}
+	want := []string{
+		"POST /collections",
+		"GET /collections/products/documents/export",
+		"POST /collections/products_v2/documents/import",
+		"DELETE /collections/products",
+	}
+	if strings.Join(calls, ", ") != strings.Join(want, ", ") {
+		t.Errorf("requests = %v, want %v", calls, want)
+	}
+}
+
+func TestReindexKeepsSourceWhenImportFails(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/collections":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"name": "products_v2", "fields": []}`))
+		case r.URL.Path == "/collections/products/documents/export":
+			_, _ = w.Write([]byte(`{"id":"1","price":"free"}`))
+		case r.URL.Path == "/collections/products_v2/documents/import":
+			_, _ = w.Write([]byte(`{"success":false,"error":"Field price must be an int32."}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	_, err := client.Reindex(context.Background(), "products", &Collection{Name: "products_v2"})
+	if err == nil {
+		t.Fatal("Reindex succeeded, want error")
+	}
+	if !strings.Contains(err.Error(), "Field price must be an int32.") {
+		t.Errorf("error = %q, want the failed document's error", err.Error())
+	}
+	if len(deleted) != 1 || deleted[0] != "/collections/products_v2" {
+		t.Errorf("deleted %v, want only the new collection /collections/products_v2", deleted)
+	}
+}
+
+func TestConfigureTLSTrustsCustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("failed to write CA file: %v", err)
+	}
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse server URL: %v", err)
+	}
+	port, _ := strconv.Atoi(serverURL.Port())
+	newClient := func() *ServerClient {
+		return NewServerClient(serverURL.Hostname(), "test-api-key", port, "https")
+	}
+
+	t.Run("default pool rejects the certificate", func(t *testing.T) {
+		if _, err := newClient().GetHealth(context.Background()); err == nil {
+			t.Fatal("GetHealth succeeded against a server signed by an unknown CA")
+		}
+	})
+
+	t.Run("ca_cert_file is trusted", func(t *testing.T) {
+		c := newClient()
+		if err := c.ConfigureTLS(caFile, false); err != nil {
+			t.Fatalf("ConfigureTLS returned %v", err)
+		}
+		healthy, err := c.GetHealth(context.Background())
+		if err != nil {
+			t.Fatalf("GetHealth returned %v", err)
+		}
+		if !healthy {
+			t.Error("GetHealth() = false, want true")
+		}
+	})
+
+	t.Run("insecure_skip_verify", func(t *testing.T) {
+		c := newClient()
+		if err := c.ConfigureTLS("", true); err != nil {
+			t.Fatalf("ConfigureTLS returned %v", err)
+		}
+		if _, err := c.GetHealth(context.Background()); err != nil {
+			t.Fatalf("GetHealth returned %v", err)
+		}
+	})
+}
+
+func TestConfigureTLSRejectsInvalidCAFile(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("failed to write CA file: %v", err)
+	}
+
+	c := NewServerClient("localhost", "test-api-key", 8108, "https")
+
+	if err := c.ConfigureTLS(caFile, false); err == nil {
+		t.Error("ConfigureTLS accepted a file without PEM certificates")
+	}
+	if err := c.ConfigureTLS(filepath.Join(t.TempDir(), "missing.pem"), false); err == nil {
+		t.Error("ConfigureTLS accepted a missing file")
+	}
+}
+
+func TestConfigureHTTP2ConcurrentImportsShareOneConnection(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		start func(*httptest.Server)
+	}{
+		{name: "https", start: func(s *httptest.Server) { s.EnableHTTP2 = true; s.StartTLS() }},
+		{name: "h2c", start: func(s *httptest.Server) {
+			s.Config.Protocols = new(http.Protocols)
+			s.Config.Protocols.SetUnencryptedHTTP2(true)
+			s.Start()
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var connections, http1Requests atomic.Int32
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.ProtoMajor != 2 {
+					http1Requests.Add(1)
+				}
+				_, _ = io.Copy(io.Discard, r.Body)
+				_, _ = w.Write([]byte(`{"success": true}`))
+			}))
+			server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					connections.Add(1)
+				}
+			}
+			tt.start(server)
+			defer server.Close()
+
+			serverURL, err := url.Parse(server.URL)
+			if err != nil {
+				t.Fatalf("failed to parse server URL: %v", err)
+			}
+			port, _ := strconv.Atoi(serverURL.Port())
+			c := NewServerClient(serverURL.Hostname(), "test-api-key", port, serverURL.Scheme)
+			if err := c.ConfigureTLS("", true); err != nil {
+				t.Fatalf("ConfigureTLS returned %v", err)
+			}
+			c.ConfigureHTTP2(true)
+
+			ctx := context.Background()
+			// The first request opens the connection the others multiplex on.
+			if err := c.ImportDocuments(ctx, "products", strings.NewReader(`{"id": "0"}`), ""); err != nil {
+				t.Fatalf("ImportDocuments returned %v", err)
+			}
+			var wg sync.WaitGroup
+			for i := 1; i <= 32; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := c.ImportDocuments(ctx, "products", strings.NewReader(fmt.Sprintf(`{"id": "%d"}`, i)), ""); err != nil {
+						t.Errorf("ImportDocuments returned %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got := http1Requests.Load(); got != 0 {
+				t.Errorf("%d requests used HTTP/1, want all on HTTP/2", got)
+			}
+			if got := connections.Load(); got != 1 {
+				t.Errorf("imports opened %d connections, want 1", got)
+			}
+		})
+	}
+}
+
+func TestCreateCollectionsRollsBackOnFailure(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			var col Collection
+			if err := json.NewDecoder(r.Body).Decode(&col); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if col.Name == "reviews" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message": "Field ` + "`rating`" + ` has an invalid data type."}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(col)
+		case http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/collections/"))
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	created, err := client.CreateCollections(context.Background(), []*Collection{
+		{Name: "products"},
+		{Name: "brands"},
+		{Name: "reviews"},
+		{Name: "orders"},
+	})
+	if err == nil {
+		t.Fatal("CreateCollections succeeded, want error")
+	}
+	if !strings.Contains(err.Error(), "reviews") {
+		t.Errorf("error = %q, want it to name the failed collection", err.Error())
+	}
+
+	var names []string
+	for _, c := range created {
+		names = append(names, c.Name)
+	}
+	if strings.Join(names, ",") != "products,brands" {
+		t.Errorf("created = %v, want [products brands]", names)
+	}
+	if strings.Join(deleted, ",") != "brands,products" {
+		t.Errorf("deleted = %v, want [brands products]", deleted)
+	}
+}
+
+func TestNormalizeAnalyticsRule(t *testing.T) {
+	tests := []struct {
+		name string
+		rule AnalyticsRule
+		want AnalyticsRule
+	}{
+		{
+			name: "v30 flat rule is unchanged",
+			rule: AnalyticsRule{
+				Name: "popular", Type: "popular_queries", Collection: "products", EventType: "search",
+				Params: map[string]any{"destination_collection": "product_queries", "limit": float64(1000)},
+			},
+			want: AnalyticsRule{
+				Name: "popular", Type: "popular_queries", Collection: "products", EventType: "search",
+				Params: map[string]any{"destination_collection": "product_queries", "limit": float64(1000)},
+			},
+		},
+		{
+			name: "legacy popular queries rule",
+			rule: AnalyticsRule{
+				Name: "popular", Type: "popular_queries",
+				Params: map[string]any{
+					"source":      map[string]any{"collections": []any{"products"}},
+					"destination": map[string]any{"collection": "product_queries"},
+					"limit":       float64(1000),
+				},
+			},
+			want: AnalyticsRule{
+				Name: "popular", Type: "popular_queries", Collection: "products", EventType: "search",
+				Params: map[string]any{"destination_collection": "product_queries", "limit": float64(1000)},
+			},
+		},
+		{
+			name: "legacy counter rule",
+			rule: AnalyticsRule{
+				Name: "clicks", Type: "counter",
+				Params: map[string]any{
+					"source": map[string]any{
+						"collections": []any{"products"},
+						"events":      []any{map[string]any{"type": "click", "weight": float64(1), "name": "products_click"}},
+					},
+					"destination": map[string]any{"collection": "products", "counter_field": "popularity"},
+				},
+			},
+			want: AnalyticsRule{
+				Name: "clicks", Type: "counter", Collection: "products", EventType: "click",
+				Params: map[string]any{"destination_collection": "products", "counter_field": "popularity", "weight": float64(1)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NormalizeAnalyticsRule(tt.rule)
+
+			gotJSON, _ := json.Marshal(got)
+			wantJSON, _ := json.Marshal(tt.want)
+			if string(gotJSON) != string(wantJSON) {
+				t.Errorf("NormalizeAnalyticsRule() = %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+// documentStore is a fake collection that upserts documents by id, the way
+// Typesense does for action=upsert.
+type documentStore struct {
+	docs map[string]map[string]any
+}
+
+func (s *documentStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	upsert := func(doc map[string]any) string {
+		id, _ := doc["id"].(string)
+		if id == "" {
+			return `{"success":false,"error":"Document is missing an id."}`
+		}
+		s.docs[id] = doc
+		return `{"success":true}`
+	}
+
+	switch r.URL.Path {
+	case "/collections/products/documents/import":
+		scanner := bufio.NewScanner(r.Body)
+		var results []string
+		for scanner.Scan() {
+			var doc map[string]any
+			_ = json.Unmarshal(scanner.Bytes(), &doc)
+			results = append(results, upsert(doc))
+		}
+		_, _ = w.Write([]byte(strings.Join(results, "\n")))
+	case "/collections/products/documents":
+		var doc map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&doc)
+		upsert(doc)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(doc)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestImportDocumentsKeyedOnCustomField(t *testing.T) {
+	store := &documentStore{docs: map[string]map[string]any{}}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+	ctx := context.Background()
+
+	first := `{"sku":"A1","title":"Boot"}` + "\n" + `{"sku":1002,"title":"Sandal"}` + "\n"
+	if err := client.ImportDocuments(ctx, "products", strings.NewReader(first), "sku"); err != nil {
+		t.Fatalf("ImportDocuments returned %v", err)
+	}
+
+	// Re-importing a document with the same sku replaces it.
+	if err := client.ImportDocuments(ctx, "products", strings.NewReader(`{"sku":"A1","title":"Hiking boot"}`), "sku"); err != nil {
+		t.Fatalf("ImportDocuments returned %v", err)
+	}
+
+	if len(store.docs) != 2 {
+		t.Fatalf("stored %d documents, want 2: %v", len(store.docs), store.docs)
+	}
+	if got := store.docs["A1"]["title"]; got != "Hiking boot" {
+		t.Errorf("A1 title = %v, want the upserted Hiking boot", got)
+	}
+	if got := store.docs["1002"]["sku"]; got != float64(1002) {
+		t.Errorf("1002 sku = %v, want the numeric sku kept as is", got)
+	}
+
+	// UpsertDocument keys on the same field.
+	result, err := client.UpsertDocument(ctx, "products", map[string]any{"sku": "A1", "title": "Trail boot"}, "sku")
+	if err != nil {
+		t.Fatalf("UpsertDocument returned %v", err)
+	}
+	if result["id"] != "A1" {
+		t.Errorf("upserted id = %v, want A1", result["id"])
+	}
+	if len(store.docs) != 2 || store.docs["A1"]["title"] != "Trail boot" {
+		t.Errorf("documents after upsert = %v, want A1 replaced", store.docs)
+	}
+}
+
+func TestImportDocumentsRejectsMissingCustomIDField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	docs := `{"sku":"A1"}` + "\n" + `{"title":"no sku"}`
+	err := client.ImportDocuments(context.Background(), "products", strings.NewReader(docs), "sku")
+	if err == nil || !strings.Contains(err.Error(), `line 2: missing id field "sku"`) {
+		t.Fatalf("ImportDocuments error = %v, want missing sku on line 2", err)
+	}
+}
+
+func TestGetDocumentKeepsLargeIntegers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/collections/flags/documents/checkout%2Fv2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "checkout/v2", "enabled": true, "rollout_seed": 9007199254740993}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	doc, err := client.GetDocument(context.Background(), "flags", "checkout/v2")
+	if err != nil {
+		t.Fatalf("GetDocument returned %v", err)
+	}
+	got, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("failed to encode document: %v", err)
+	}
+	want := `{"enabled":true,"id":"checkout/v2","rollout_seed":9007199254740993}`
+	if string(got) != want {
+		t.Errorf("document = %s, want %s", got, want)
+	}
+
+	missing, err := client.GetDocument(context.Background(), "flags", "unknown")
+	if err != nil || missing != nil {
+		t.Errorf("GetDocument for a missing document = %v, %v, want nil, nil", missing, err)
+	}
+}
+
+func TestGetCollectionJSONKeepsUnmodeledAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/collections/products" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+  "name": "products",
+  "fields": [{"name": "title", "type": "string", "future_option": 7}],
+  "some_new_setting": true
+}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	got, err := client.GetCollectionJSON(context.Background(), "products")
+	if err != nil {
+		t.Fatalf("GetCollectionJSON returned %v", err)
+	}
+	want := `{"name":"products","fields":[{"name":"title","type":"string","future_option":7}],"some_new_setting":true}`
+	if string(got) != want {
+		t.Errorf("GetCollectionJSON = %s, want %s", got, want)
+	}
+
+	missing, err := client.GetCollectionJSON(context.Background(), "missing")
+	if err != nil || missing != nil {
+		t.Errorf("GetCollectionJSON for a missing collection = %s, %v, want nil, nil", missing, err)
+	}
+}
+
+func TestUpdateConfigSendsOnlySetValues(t *testing.T) {
+	var posted map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/config" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&posted); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"success": true}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	slow := int64(2000)
+	cache := int64(500)
+	if err := client.UpdateConfig(context.Background(), &ServerConfig{LogSlowRequestsTimeMs: &slow, CacheNumEntries: &cache}); err != nil {
+		t.Fatalf("UpdateConfig returned %v", err)
+	}
+
+	want := map[string]any{"log-slow-requests-time-ms": float64(2000), "cache-num-entries": float64(500)}
+	if len(posted) != len(want) {
+		t.Fatalf("posted %v, want %v", posted, want)
+	}
+	for key, value := range want {
+		if posted[key] != value {
+			t.Errorf("posted %s = %v, want %v", key, posted[key], value)
+		}
+	}
+}
+
+func TestGetConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		wantNil  bool
+		wantSlow int64
+	}{
+		{name: "settings reported", status: http.StatusOK, body: `{"log-slow-requests-time-ms": 2000, "cache-num-entries": 500}`, wantSlow: 2000},
+		{name: "endpoint not exposed", status: http.StatusNotFound, body: `{"message": "Not Found"}`, wantNil: true},
+		{name: "method not allowed", status: http.StatusMethodNotAllowed, wantNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+			config, err := client.GetConfig(context.Background())
+			if err != nil {
+				t.Fatalf("GetConfig returned %v", err)
+			}
+			if tt.wantNil {
+				if config != nil {
+					t.Fatalf("GetConfig = %+v, want nil", config)
+				}
+				return
+			}
+			if config == nil || config.LogSlowRequestsTimeMs == nil || *config.LogSlowRequestsTimeMs != tt.wantSlow {
+				t.Fatalf("GetConfig = %+v, want log-slow-requests-time-ms %d", config, tt.wantSlow)
+			}
+			if config.CacheNumEntries == nil || *config.CacheNumEntries != 500 {
+				t.Errorf("cache-num-entries = %v, want 500", config.CacheNumEntries)
+			}
+		})
+	}
+}
+
+func TestVersionGatedEndpointsReportUnsupportedVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		call       func() error
+		endpoint   string
+		minVersion string
+	}{
+		{name: "list synonym sets", endpoint: "synonym_sets", minVersion: "30.0", call: func() error {
+			_, err := client.ListSynonymSets(ctx)
+			return err
+		}},
+		{name: "ensure synonym set", endpoint: "synonym_sets", minVersion: "30.0", call: func() error {
+			return client.EnsureSynonymSetExists(ctx, "products")
+		}},
+		{name: "list curation sets", endpoint: "curation_sets", minVersion: "30.0", call: func() error {
+			_, err := client.ListCurationSets(ctx)
+			return err
+		}},
+		{name: "upsert curation set", endpoint: "curation_sets", minVersion: "30.0", call: func() error {
+			_, err := client.UpsertCurationSet(ctx, &CurationSet{Name: "products"})
+			return err
+		}},
+		{name: "list NL search models", endpoint: "nl_search_models", minVersion: "29.0", call: func() error {
+			_, err := client.ListNLSearchModels(ctx)
+			return err
+		}},
+		{name: "create NL search model", endpoint: "nl_search_models", minVersion: "29.0", call: func() error {
+			_, err := client.CreateNLSearchModel(ctx, &NLSearchModel{ID: "nl"})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			var versionErr *VersionUnsupportedError
+			if !errors.As(err, &versionErr) {
+				t.Fatalf("error = %v, want a VersionUnsupportedError", err)
+			}
+			if versionErr.Endpoint != tt.endpoint || versionErr.MinVersion != tt.minVersion {
+				t.Errorf("error = %+v, want endpoint %s and version %s", versionErr, tt.endpoint, tt.minVersion)
+			}
+			if !strings.Contains(err.Error(), "requires Typesense v"+tt.minVersion) {
+				t.Errorf("error message %q does not name the required version", err)
+			}
+		})
+	}
+}
+
+func TestGetSynonymSetNotFoundIsNotVersionError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	set, err := client.GetSynonymSet(context.Background(), "products")
+	if err != nil || set != nil {
+		t.Fatalf("GetSynonymSet = %+v, %v; want a missing set to return nil, nil", set, err)
+	}
+}
+
+func TestUpdateCollectionDropOnly(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/collections/products" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ = io.ReadAll(r.Body)
+
+		// Like Typesense, reject drop entries with properties besides name.
+		var update struct {
+			Fields []map[string]any `json:"fields"`
+		}
+		if err := json.Unmarshal(body, &update); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, f := range update.Fields {
+			if f["drop"] == true && len(f) != 2 {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message": "Field drop must only contain name and drop."}`))
+				return
+			}
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	update := &Collection{Fields: []CollectionField{{Name: "rating", Drop: true}}}
+	if _, err := client.UpdateCollection(context.Background(), "products", update); err != nil {
+		t.Fatalf("UpdateCollection returned %v", err)
+	}
+
+	want := `{"fields":[{"name":"rating","drop":true}]}`
+	if string(body) != want {
+		t.Errorf("PATCH body = %s, want %s", body, want)
+	}
+}
+
+func TestUpdateCollectionMetadataSendsEmptyObject(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/collections/products" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"name": "products", "metadata": {}}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	if _, err := client.UpdateCollectionMetadata(context.Background(), "products", nil); err != nil {
+		t.Fatalf("UpdateCollectionMetadata returned %v", err)
+	}
+
+	want := `{"metadata":{}}`
+	if string(body) != want {
+		t.Errorf("PATCH body = %s, want %s", body, want)
+	}
+}
+
+func TestUpdateCollectionSetsSendsBothLists(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/collections/products" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"name": "products", "synonym_sets": ["shared"]}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	if _, err := client.UpdateCollectionSets(context.Background(), "products", []string{"shared"}, nil); err != nil {
+		t.Fatalf("UpdateCollectionSets returned %v", err)
+	}
+
+	want := `{"curation_sets":[],"synonym_sets":["shared"]}`
+	if string(body) != want {
+		t.Errorf("PATCH body = %s, want %s", body, want)
+	}
+}
+
+func TestTruncateDocuments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/collections/products/documents" || r.URL.RawQuery != "truncate=true" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+		}
+		_, _ = w.Write([]byte(`{"num_deleted": 42}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	deleted, err := client.TruncateDocuments(context.Background(), "products")
+	if err != nil || deleted != 42 {
+		t.Errorf("TruncateDocuments = %d, %v; want 42, nil", deleted, err)
+	}
+}
+
+func TestTruncateDocumentsOfMissingCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	deleted, err := client.TruncateDocuments(context.Background(), "products")
+	if err != nil || deleted != 0 {
+		t.Errorf("TruncateDocuments = %d, %v; want 0, nil for a missing collection", deleted, err)
+	}
+}
+
+func TestCollectionFieldMarshalKeepsNonDropFields(t *testing.T) {
+	sort := true
+	got, err := json.Marshal(CollectionField{Name: "rating", Type: "int32", Facet: true, Sort: &sort})
+	if err != nil {
+		t.Fatalf("Marshal returned %v", err)
+	}
+
+	want := `{"name":"rating","type":"int32","facet":true,"sort":true}`
+	if string(got) != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+}
+
+func TestCollectionFieldNumDimDistinguishesUnsetFromZero(t *testing.T) {
+	zero := int64(0)
+	for _, tt := range []struct {
+		field CollectionField
+		want  string
+	}{
+		{field: CollectionField{Name: "vec", Type: "float[]"}, want: `{"name":"vec","type":"float[]"}`},
+		{field: CollectionField{Name: "vec", Type: "float[]", NumDim: &zero}, want: `{"name":"vec","type":"float[]","num_dim":0}`},
+	} {
+		got, err := json.Marshal(tt.field)
+		if err != nil {
+			t.Fatalf("Marshal returned %v", err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("Marshal = %s, want %s", got, tt.want)
+		}
+
+		var decoded CollectionField
+		if err := json.Unmarshal(got, &decoded); err != nil {
+			t.Fatalf("Unmarshal returned %v", err)
+		}
+		if (decoded.NumDim == nil) != (tt.field.NumDim == nil) {
+			t.Errorf("num_dim after round trip = %v, want %v", decoded.NumDim, tt.field.NumDim)
+		}
+	}
+}
+
+func TestMultiSearch(t *testing.T) {
+	var body map[string][]map[string]any
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/multi_search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		query = r.URL.Query()
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"results": [
+			{"found": 3, "hits": []},
+			{"code": 404, "error": "Could not find a field named ` + "`nope`" + ` in the schema."}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	searches := []map[string]any{
+		{"collection": "products", "q": "shoe", "filter_by": "price:>10"},
+		{"collection": "products", "q": "shoe", "query_by": "nope"},
+	}
+	results, err := client.MultiSearch(context.Background(), searches, map[string]string{"query_by": "title"})
+	if err != nil {
+		t.Fatalf("MultiSearch returned %v", err)
+	}
+
+	if got := query.Get("query_by"); got != "title" {
+		t.Errorf("common query_by = %q, want title", got)
+	}
+	if len(body["searches"]) != 2 || body["searches"][0]["filter_by"] != "price:>10" {
+		t.Errorf("searches sent = %v, want the given parameters unchanged", body["searches"])
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].Found != 3 || results[0].Error != "" {
+		t.Errorf("results[0] = %+v, want found 3", results[0])
+	}
+	if results[1].Code != 404 || !strings.Contains(results[1].Error, "nope") {
+		t.Errorf("results[1] = %+v, want the per-search error", results[1])
+	}
+}
+
+func TestSetBasePathPrefixesRequests(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/search/collections/products":
+			_, _ = w.Write([]byte(`{"name": "products", "fields": []}`))
+		case "/search/health":
+			_, _ = w.Write([]byte(`{"ok": true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse server URL: %v", err)
+	}
+	port, _ := strconv.Atoi(serverURL.Port())
+
+	for _, basePath := range []string{"/search", "search/", "/search/"} {
+		paths = nil
+		c := NewServerClient(serverURL.Hostname(), "test-api-key", port, "http")
+		c.SetBasePath(basePath)
+
+		collection, err := c.GetCollection(context.Background(), "products")
+		if err != nil || collection == nil {
+			t.Fatalf("SetBasePath(%q): GetCollection = %v, %v, want the collection", basePath, collection, err)
+		}
+		healthy, err := c.GetHealth(context.Background())
+		if err != nil || !healthy {
+			t.Fatalf("SetBasePath(%q): GetHealth = %v, %v, want true", basePath, healthy, err)
+		}
+		if want := []string{"/search/collections/products", "/search/health"}; !reflect.DeepEqual(paths, want) {
+			t.Errorf("SetBasePath(%q): requested %v, want %v", basePath, paths, want)
+		}
+		if c.Host() != serverURL.Host {
+			t.Errorf("SetBasePath(%q): Host() = %s, want %s", basePath, c.Host(), serverURL.Host)
+		}
+	}
+}
+
+func TestListSynonymsAndOverridesPaginate(t *testing.T) {
+	// Each list holds one full page and one more item.
+	total := listPageSize + 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			t.Errorf("%s requested without a limit", r.URL)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		var items []map[string]any
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, map[string]any{"id": fmt.Sprintf("item-%d", i)})
+		}
+		switch r.URL.Path {
+		case "/collections/products/synonyms":
+			_ = json.NewEncoder(w).Encode(map[string]any{"synonyms": items})
+		case "/collections/products/overrides":
+			_ = json.NewEncoder(w).Encode(map[string]any{"overrides": items})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	synonyms, err := c.ListSynonyms(context.Background(), "products")
+	if err != nil {
+		t.Fatalf("ListSynonyms returned %v", err)
+	}
+	if len(synonyms) != total || synonyms[total-1].ID != fmt.Sprintf("item-%d", total-1) {
+		t.Errorf("ListSynonyms returned %d synonyms, want %d across two pages", len(synonyms), total)
+	}
+
+	overrides, err := c.ListOverrides(context.Background(), "products")
+	if err != nil {
+		t.Fatalf("ListOverrides returned %v", err)
+	}
+	if len(overrides) != total || overrides[total-1].ID != fmt.Sprintf("item-%d", total-1) {
+		t.Errorf("ListOverrides returned %d overrides, want %d across two pages", len(overrides), total)
+	}
+}
+
+func TestListSynonymsServerIgnoringLimit(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		items := make([]map[string]any, listPageSize)
+		for i := range items {
+			items[i] = map[string]any{"id": fmt.Sprintf("item-%d", i)}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"synonyms": items})
+	}))
+	defer server.Close()
+
+	c := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+
+	synonyms, err := c.ListSynonyms(context.Background(), "products")
+	if err != nil {
+		t.Fatalf("ListSynonyms returned %v", err)
+	}
+	if len(synonyms) != listPageSize || requests != 2 {
+		t.Errorf("ListSynonyms returned %d synonyms in %d requests, want %d in 2", len(synonyms), requests, listPageSize)
+	}
+}
+
+func TestSetExtraHeadersReachServer(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	c := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+	err := c.SetExtraHeaders(map[string]string{
+		"Authorization": "Bearer proxy-token",
+		"X-Tenant":      "search",
+		"Content-Type":  "text/html",
+	})
+	if err != nil {
+		t.Fatalf("SetExtraHeaders returned %v", err)
+	}
+
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("GetHealth returned %v", err)
+	}
+	if got.Get("Authorization") != "Bearer proxy-token" || got.Get("X-Tenant") != "search" {
+		t.Errorf("request headers = %v, want the extra headers", got)
+	}
+	if got.Get("X-TYPESENSE-API-KEY") != "test-api-key" || got.Get("Content-Type") != "application/json" {
+		t.Errorf("request headers = %v, want the API key and JSON content type kept", got)
+	}
+
+	if err := c.SetExtraHeaders(map[string]string{"x-typesense-api-key": "other"}); err == nil {
+		t.Error("SetExtraHeaders accepted an X-TYPESENSE-API-KEY header")
+	}
+}
+
+func TestMergeSynonymSetItemsRetriesConflict(t *testing.T) {
+	// Another writer adds "sofa" between our first read and PUT, which the
+	// server rejects with a conflict.
+	stored := []SynonymItem{{ID: "couch", Synonyms: []string{"couch", "settee"}}}
+	puts := 0
+	var written SynonymSet
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/synonym_sets/products" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(SynonymSet{Name: "products", Synonyms: stored})
+		case http.MethodPut:
+			puts++
+			if puts == 1 {
+				stored = append(stored, SynonymItem{ID: "sofa", Synonyms: []string{"sofa", "divan"}})
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"message": "synonym set was modified"}`))
+				return
+			}
+			if err := json.NewDecoder(r.Body).Decode(&written); err != nil {
+				t.Errorf("failed to decode PUT body: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(written)
+		}
+	}))
+	defer server.Close()
+
+	c := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+	item := SynonymItem{ID: "tv", Synonyms: []string{"tv", "television"}}
+	if err := c.MergeSynonymSetItems(context.Background(), "products", []SynonymItem{item}); err != nil {
+		t.Fatalf("MergeSynonymSetItems returned %v", err)
+	}
+
+	if puts != 2 {
+		t.Errorf("sent %d PUTs, want a retry after the conflict", puts)
+	}
+	var ids []string
+	for _, s := range written.Synonyms {
+		ids = append(ids, s.ID)
+	}
+	if want := []string{"couch", "sofa", "tv"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("written items = %v, want %v: the concurrent item and ours both kept", ids, want)
+	}
+}
+
+func TestMergeSynonymSetItemsGivesUpAfterRetries(t *testing.T) {
+	puts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts++
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+	c.SetSynonymSetWriteRetries(1)
+	err := c.MergeSynonymSetItems(context.Background(), "products", []SynonymItem{{ID: "tv", Synonyms: []string{"tv"}}})
+	if !IsConflict(err) {
+		t.Fatalf("MergeSynonymSetItems returned %v, want a conflict error", err)
+	}
+	if puts != 2 {
+		t.Errorf("sent %d PUTs, want 2 (one retry)", puts)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		providerVersion, terraformVersion, want string
+	}{
+		{"1.2.0", "1.9.0", "Terraform/1.9.0 terraform-provider-typesense/1.2.0"},
+		{"1.2.0", "", "terraform-provider-typesense/1.2.0"},
+		{"", "", "terraform-provider-typesense"},
+	}
+	for _, tt := range tests {
+		if got := UserAgent(tt.providerVersion, tt.terraformVersion); got != tt.want {
+			t.Errorf("UserAgent(%q, %q) = %q, want %q", tt.providerVersion, tt.terraformVersion, got, tt.want)
+		}
+	}
+}
+
+func TestSetUserAgentReachesServer(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		_, _ = w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	c := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+	c.SetUserAgent(UserAgent("1.2.0", "1.9.0"))
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("GetHealth returned %v", err)
+	}
+	if got != "Terraform/1.9.0 terraform-provider-typesense/1.2.0" {
+		t.Errorf("User-Agent = %q, want the provider and Terraform versions", got)
+	}
+
+	if err := c.SetExtraHeaders(map[string]string{"User-Agent": "custom"}); err != nil {
+		t.Fatalf("SetExtraHeaders returned %v", err)
+	}
+	if _, err := c.GetHealth(context.Background()); err != nil {
+		t.Fatalf("GetHealth returned %v", err)
+	}
+	if got != "custom" {
+		t.Errorf("User-Agent = %q, want the extra header to take precedence", got)
+	}
+}
+
+func TestValidateCollectionSchema(t *testing.T) {
+	var created, deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			var col Collection
+			_ = json.NewDecoder(r.Body).Decode(&col)
+			if col.DefaultSortingField == "missing" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"message": "Default sorting field is defined as ` + "`missing`" + ` but is not found in the schema."}`))
+				return
+			}
+			created = append(created, col.Name)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(col)
+		case http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/collections/"))
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	c := &ServerClient{httpClient: server.Client(), apiKey: "test-api-key", baseURL: server.URL}
+	fields := []CollectionField{{Name: "title", Type: "string"}}
+
+	problem, err := c.ValidateCollectionSchema(context.Background(), &Collection{Name: "products", Fields: fields}, "staging_")
+	if err != nil || problem != "" {
+		t.Fatalf("ValidateCollectionSchema = %q, %v, want a valid schema", problem, err)
+	}
+	if len(created) != 1 || !strings.HasPrefix(created[0], "staging_"+schemaValidationPrefix) || !slices.Equal(created, deleted) {
+		t.Errorf("created %v and deleted %v, want one temporary collection created and deleted", created, deleted)
+	}
+
+	problem, err = c.ValidateCollectionSchema(context.Background(), &Collection{Fields: fields, DefaultSortingField: "missing"}, "")
+	if err != nil {
+		t.Fatalf("ValidateCollectionSchema returned %v", err)
+	}
+	if !strings.Contains(problem, "Default sorting field") {
+		t.Errorf("problem = %q, want the server's message", problem)
+	}
+}
@@ -0,0 +1,20 @@
+package client
+
+import "fmt"
+
+// userAgentProduct names the provider in the User-Agent header.
+const userAgentProduct = "terraform-provider-typesense"
+
+// UserAgent returns the User-Agent header value for requests made by the
+// provider, e.g. "Terraform/1.9.0 terraform-provider-typesense/1.2.0".
+// Either version may be empty when it is not known.
+func UserAgent(providerVersion, terraformVersion string) string {
+	ua := userAgentProduct
+	if providerVersion != "" {
+		ua += "/" + providerVersion
+	}
+	if terraformVersion != "" {
+		ua = fmt.Sprintf("Terraform/%s %s", terraformVersion, ua)
+	}
+	return ua
+}
@@ -0,0 +1,147 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AnalyticsRulesDataSource{}
+
+// NewAnalyticsRulesDataSource creates a new analytics rules data source
+func NewAnalyticsRulesDataSource() datasource.DataSource {
+	return &AnalyticsRulesDataSource{}
+}
+
+// AnalyticsRulesDataSource defines the data source implementation
+type AnalyticsRulesDataSource struct {
+	client *client.ServerClient
+}
+
+// AnalyticsRulesDataSourceModel describes the data source data model
+type AnalyticsRulesDataSourceModel struct {
+	Rules types.List `tfsdk:"rules"`
+}
+
+var analyticsRuleAttrTypes = map[string]attr.Type{
+	"name":       types.StringType,
+	"type":       types.StringType,
+	"collection": types.StringType,
+	"event_type": types.StringType,
+	"params":     types.StringType,
+}
+
+func (d *AnalyticsRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceAnalyticsRules)
+}
+
+func (d *AnalyticsRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all analytics rules on the Typesense server. Rules from servers before v30 are reported in the v30 flat form, so the output is the same across server versions.",
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.ListNestedAttribute{
+				Description: "List of analytics rules.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the rule.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The rule type (popular_queries, nohits_queries, counter or log).",
+							Computed:    true,
+						},
+						"collection": schema.StringAttribute{
+							Description: "The collection whose searches or events the rule tracks.",
+							Computed:    true,
+						},
+						"event_type": schema.StringAttribute{
+							Description: "The event the rule tracks (e.g., search, click, conversion).",
+							Computed:    true,
+						},
+						"params": schema.StringAttribute{
+							Description: "JSON-encoded rule parameters in the flat form, e.g. destination_collection, counter_field, limit.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AnalyticsRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read analytics rules.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *AnalyticsRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AnalyticsRulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := d.client.ListAnalyticsRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list analytics rules: %s", err))
+		return
+	}
+
+	ruleValues := make([]attr.Value, len(rules))
+	for i, r := range rules {
+		rule := client.NormalizeAnalyticsRule(r)
+
+		params := types.StringNull()
+		if len(rule.Params) > 0 {
+			paramsBytes, err := json.Marshal(rule.Params)
+			if err != nil {
+				resp.Diagnostics.AddError("Serialization Error", fmt.Sprintf("Unable to serialize params of analytics rule %s: %s", rule.Name, err))
+				return
+			}
+			params = types.StringValue(string(paramsBytes))
+		}
+
+		ruleValues[i], _ = types.ObjectValue(analyticsRuleAttrTypes, map[string]attr.Value{
+			"name":       types.StringValue(rule.Name),
+			"type":       types.StringValue(rule.Type),
+			"collection": optionalString(rule.Collection),
+			"event_type": optionalString(rule.EventType),
+			"params":     params,
+		})
+	}
+
+	data.Rules, _ = types.ListValue(types.ObjectType{AttrTypes: analyticsRuleAttrTypes}, ruleValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAnalyticsRulesDataSource_basic(t *testing.T) {
+	ruleName := acctest.RandomWithPrefix("test-rule")
+	collectionName := acctest.RandomWithPrefix("test-collection")
+	queriesName := acctest.RandomWithPrefix("test-queries")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_collection" "source" {
+  name = %[2]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+}
+
+resource "typesense_collection" "queries" {
+  name = %[3]q
+
+  field {
+    name = "q"
+    type = "string"
+  }
+
+  field {
+    name = "count"
+    type = "int32"
+  }
+}
+
+resource "typesense_analytics_rule" "test" {
+  name       = %[1]q
+  type       = "popular_queries"
+  collection = typesense_collection.source.name
+  event_type = "search"
+  params = jsonencode({
+    destination_collection = typesense_collection.queries.name
+    limit                  = 1000
+  })
+}
+
+data "typesense_analytics_rules" "all" {
+  depends_on = [typesense_analytics_rule.test]
+}
+`, ruleName, collectionName, queriesName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.typesense_analytics_rules.all", "rules.*", map[string]string{
+						"name":       ruleName,
+						"type":       "popular_queries",
+						"collection": collectionName,
+						"event_type": "search",
+					}),
+				),
+			},
+		},
+	})
+}
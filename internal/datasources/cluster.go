@@ -0,0 +1,156 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ClusterDataSource{}
+
+// NewClusterDataSource creates a new Cloud cluster data source
+func NewClusterDataSource() datasource.DataSource {
+	return &ClusterDataSource{}
+}
+
+// ClusterDataSource reads an existing Typesense Cloud cluster, e.g. one
+// provisioned outside Terraform.
+type ClusterDataSource struct {
+	client *client.CloudClient
+}
+
+// ClusterDataSourceModel describes the data source data model
+type ClusterDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Memory                 types.String `tfsdk:"memory"`
+	VCPU                   types.String `tfsdk:"vcpu"`
+	HighAvailability       types.String `tfsdk:"high_availability"`
+	Regions                types.List   `tfsdk:"regions"`
+	TypesenseServerVersion types.String `tfsdk:"typesense_server_version"`
+	Status                 types.String `tfsdk:"status"`
+	Hostname               types.String `tfsdk:"hostname"`
+	NodeHostnames          types.List   `tfsdk:"node_hostnames"`
+}
+
+func (d *ClusterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceCluster)
+}
+
+func (d *ClusterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing Typesense Cloud cluster by ID. The cluster's API keys are not exposed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the cluster.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the cluster.",
+				Computed:    true,
+			},
+			"memory": schema.StringAttribute{
+				Description: "Memory configuration (e.g., \"0.5_gb\", \"1_gb\").",
+				Computed:    true,
+			},
+			"vcpu": schema.StringAttribute{
+				Description: "vCPU configuration (e.g., \"2_vcpus_4_hr_burst_per_day\").",
+				Computed:    true,
+			},
+			"high_availability": schema.StringAttribute{
+				Description: "High availability setting (\"yes\" or \"no\").",
+				Computed:    true,
+			},
+			"regions": schema.ListAttribute{
+				Description: "Regions the cluster is deployed in.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"typesense_server_version": schema.StringAttribute{
+				Description: "Typesense server version the cluster runs.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Current status of the cluster (e.g., \"in_service\").",
+				Computed:    true,
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Load balanced hostname of the cluster, to use as the provider's server_host.",
+				Computed:    true,
+			},
+			"node_hostnames": schema.ListAttribute{
+				Description: "Hostnames of the individual nodes.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ClusterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.CloudClient == nil {
+		resp.Diagnostics.AddError(
+			"Cloud Management API Not Configured",
+			"The cloud_api_key must be configured in the provider to read clusters.",
+		)
+		return
+	}
+
+	d.client = providerData.CloudClient
+}
+
+func (d *ClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := d.client.GetCluster(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster: %s", err))
+		return
+	}
+	if cluster == nil {
+		resp.Diagnostics.AddError("Cluster Not Found", fmt.Sprintf("No Typesense Cloud cluster with ID %q exists.", data.ID.ValueString()))
+		return
+	}
+
+	data.Name = types.StringValue(cluster.Name)
+	data.Memory = types.StringValue(cluster.Memory)
+	data.VCPU = types.StringValue(cluster.VCPU)
+	data.HighAvailability = types.StringValue(cluster.HighAvailability)
+	data.TypesenseServerVersion = types.StringValue(cluster.TypesenseServerVersion)
+	data.Status = types.StringValue(cluster.Status)
+	data.Hostname = optionalString(cluster.Hostnames.LoadBalanced)
+
+	regions, diags := types.ListValueFrom(ctx, types.StringType, cluster.Regions)
+	resp.Diagnostics.Append(diags...)
+	data.Regions = regions
+
+	nodes, diags := types.ListValueFrom(ctx, types.StringType, cluster.Hostnames.Nodes)
+	resp.Diagnostics.Append(diags...)
+	data.NodeHostnames = nodes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,192 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ClusterNodesDataSource{}
+
+// NewClusterNodesDataSource creates a new cluster nodes data source
+func NewClusterNodesDataSource() datasource.DataSource {
+	return &ClusterNodesDataSource{}
+}
+
+// ClusterNodesDataSource asks each node of a highly available cluster for its
+// health and version directly, so a lagging or partially upgraded cluster
+// shows up before version-gated resources are applied against it.
+type ClusterNodesDataSource struct {
+	client *client.ServerClient
+}
+
+// ClusterNodesDataSourceModel describes the data source data model
+type ClusterNodesDataSourceModel struct {
+	Hosts              types.List `tfsdk:"hosts"`
+	Nodes              types.List `tfsdk:"nodes"`
+	AllHealthy         types.Bool `tfsdk:"all_healthy"`
+	VersionsConsistent types.Bool `tfsdk:"versions_consistent"`
+}
+
+var clusterNodeAttrTypes = map[string]attr.Type{
+	"host":    types.StringType,
+	"healthy": types.BoolType,
+	"state":   types.Int64Type,
+	"version": types.StringType,
+	"error":   types.StringType,
+}
+
+func (d *ClusterNodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceClusterNodes)
+}
+
+func (d *ClusterNodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the health (/health) and state and version (/debug) of each node of a highly available cluster. " +
+			"Nodes are reached with the provider's protocol, port, base path and server API key. A node that cannot be reached is reported, not an error.",
+		Attributes: map[string]schema.Attribute{
+			"hosts": schema.ListAttribute{
+				Description: "Hostnames of the nodes, optionally with a port, e.g. the node_hostnames of a typesense_cluster data source. Without a port the provider's server_port is used.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description: "One entry per host, in the order of hosts.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "The host as given in hosts.",
+							Computed:    true,
+						},
+						"healthy": schema.BoolAttribute{
+							Description: "Whether /health on the node reports ok.",
+							Computed:    true,
+						},
+						"state": schema.Int64Attribute{
+							Description: "Raft state the node reports in /debug: 1 for the leader, 4 for a follower. Null when the node could not be read.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Typesense version the node runs. Null when the node could not be read.",
+							Computed:    true,
+						},
+						"error": schema.StringAttribute{
+							Description: "Why the node's /health or /debug could not be read. Null when both were read.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"all_healthy": schema.BoolAttribute{
+				Description: "Whether every node is healthy.",
+				Computed:    true,
+			},
+			"versions_consistent": schema.BoolAttribute{
+				Description: "Whether every node was read and reports the same version. False during a partial upgrade.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ClusterNodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read cluster nodes.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *ClusterNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterNodesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hosts []string
+	resp.Diagnostics.Append(data.Hosts.ElementsAs(ctx, &hosts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allHealthy := true
+	versionsConsistent := true
+	var firstVersion string
+	nodes := make([]attr.Value, len(hosts))
+	for i, host := range hosts {
+		node, err := d.client.ForHost(host)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to address node %s: %s", host, err))
+			return
+		}
+
+		// A node whose health cannot be read is unhealthy; error records why.
+		var problems []string
+		healthy, err := node.GetHealth(ctx)
+		if err != nil {
+			problems = append(problems, err.Error())
+		}
+		values := map[string]attr.Value{
+			"host":    types.StringValue(host),
+			"healthy": types.BoolValue(healthy),
+			"state":   types.Int64Null(),
+			"version": types.StringNull(),
+			"error":   types.StringNull(),
+		}
+
+		info, err := node.GetServerInfo(ctx)
+		if err != nil {
+			problems = append(problems, err.Error())
+			versionsConsistent = false
+		} else {
+			values["state"] = types.Int64Value(int64(info.State))
+			values["version"] = types.StringValue(info.Version)
+			if firstVersion == "" {
+				firstVersion = info.Version
+			} else if info.Version != firstVersion {
+				versionsConsistent = false
+			}
+		}
+
+		if len(problems) > 0 {
+			values["error"] = types.StringValue(strings.Join(problems, "; "))
+		}
+
+		allHealthy = allHealthy && healthy
+		nodes[i], _ = types.ObjectValue(clusterNodeAttrTypes, values)
+	}
+
+	data.Nodes, _ = types.ListValue(types.ObjectType{AttrTypes: clusterNodeAttrTypes}, nodes)
+	data.AllHealthy = types.BoolValue(allHealthy)
+	data.VersionsConsistent = types.BoolValue(versionsConsistent)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterNodesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "typesense_cluster_nodes" "this" {
+  hosts = [%q]
+}
+`, os.Getenv("TYPESENSE_HOST")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_cluster_nodes.this", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.typesense_cluster_nodes.this", "nodes.0.healthy", "true"),
+					resource.TestCheckResourceAttrSet("data.typesense_cluster_nodes.this", "nodes.0.version"),
+					resource.TestCheckResourceAttr("data.typesense_cluster_nodes.this", "all_healthy", "true"),
+					resource.TestCheckResourceAttr("data.typesense_cluster_nodes.this", "versions_consistent", "true"),
+				),
+			},
+		},
+	})
+}
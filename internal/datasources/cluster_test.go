@@ -0,0 +1,37 @@
+package datasources_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccClusterDataSource_basic reads the Typesense Cloud cluster named by
+// TYPESENSE_CLUSTER_ID, which needs a cloud management API key.
+func TestAccClusterDataSource_basic(t *testing.T) {
+	clusterID := os.Getenv("TYPESENSE_CLUSTER_ID")
+	if clusterID == "" || os.Getenv("TYPESENSE_CLOUD_API_KEY") == "" {
+		t.Skip("TYPESENSE_CLUSTER_ID and TYPESENSE_CLOUD_API_KEY must be set to read a cloud cluster")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`data "typesense_cluster" "test" {
+  id = %q
+}`, clusterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_cluster.test", "id", clusterID),
+					resource.TestCheckResourceAttrSet("data.typesense_cluster.test", "name"),
+					resource.TestCheckResourceAttrSet("data.typesense_cluster.test", "status"),
+					resource.TestCheckResourceAttrSet("data.typesense_cluster.test", "regions.#"),
+				),
+			},
+		},
+	})
+}
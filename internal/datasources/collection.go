@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CollectionDataSource{}
+
+// NewCollectionDataSource creates a new collection data source
+func NewCollectionDataSource() datasource.DataSource {
+	return &CollectionDataSource{}
+}
+
+// CollectionDataSource defines the data source implementation
+type CollectionDataSource struct {
+	client *client.ServerClient
+	prefix string
+}
+
+// CollectionDataSourceModel describes the data source data model
+type CollectionDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	SchemaJSON types.String `tfsdk:"schema_json"`
+}
+
+func (d *CollectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceCollection)
+}
+
+func (d *CollectionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing Typesense collection and returns its schema as the server reports it, for tooling that works with the raw Typesense schema JSON.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the collection. The provider's collection_name_prefix is added when talking to the server.",
+				Required:    true,
+			},
+			"schema_json": schema.StringAttribute{
+				Description: "The full GET /collections/{name} response as compact JSON, including fields, num_documents and created_at. " +
+					"Attributes the provider does not model are kept. Use jsondecode() to read it in Terraform.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *CollectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read collections.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+	d.prefix = providerData.CollectionNamePrefix
+}
+
+func (d *CollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := d.prefix + data.Name.ValueString()
+	schemaJSON, err := d.client.GetCollectionJSON(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read collection %s: %s", name, err))
+		return
+	}
+	if schemaJSON == nil {
+		resp.Diagnostics.AddError("Collection Not Found", fmt.Sprintf("No collection named %q exists on the server.", name))
+		return
+	}
+
+	data.SchemaJSON = types.StringValue(string(schemaJSON))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
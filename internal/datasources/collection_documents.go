@@ -0,0 +1,162 @@
+package datasources
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultMaxExportedDocuments bounds an export when max_documents is not set.
+const defaultMaxExportedDocuments = 1000
+
+var _ datasource.DataSource = &CollectionDocumentsDataSource{}
+
+// NewCollectionDocumentsDataSource creates a new collection documents data source
+func NewCollectionDocumentsDataSource() datasource.DataSource {
+	return &CollectionDocumentsDataSource{}
+}
+
+// CollectionDocumentsDataSource exports the documents of a collection, e.g.
+// to back up a small configuration collection.
+type CollectionDocumentsDataSource struct {
+	client *client.ServerClient
+	prefix string
+}
+
+// CollectionDocumentsDataSourceModel describes the data source data model
+type CollectionDocumentsDataSourceModel struct {
+	Collection    types.String `tfsdk:"collection"`
+	MaxDocuments  types.Int64  `tfsdk:"max_documents"`
+	JSONL         types.String `tfsdk:"jsonl"`
+	DocumentCount types.Int64  `tfsdk:"document_count"`
+}
+
+func (d *CollectionDocumentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceCollectionDocuments)
+}
+
+func (d *CollectionDocumentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports every document of a collection as JSONL, for backups of small collections. " +
+			"The content is stored in Terraform state, so keep it to collections without secrets.",
+		Attributes: map[string]schema.Attribute{
+			"collection": schema.StringAttribute{
+				Description: "The name of the collection to export. The provider's collection_name_prefix is added when talking to the server.",
+				Required:    true,
+			},
+			"max_documents": schema.Int64Attribute{
+				Description: fmt.Sprintf("Largest number of documents to export. Reading fails, instead of filling the state, when the collection has more. Defaults to %d.", defaultMaxExportedDocuments),
+				Optional:    true,
+			},
+			"jsonl": schema.StringAttribute{
+				Description: "The exported documents, one JSON object per line.",
+				Computed:    true,
+			},
+			"document_count": schema.Int64Attribute{
+				Description: "Number of exported documents.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CollectionDocumentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to export documents.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+	d.prefix = providerData.CollectionNamePrefix
+}
+
+func (d *CollectionDocumentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionDocumentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxDocuments := int64(defaultMaxExportedDocuments)
+	if !data.MaxDocuments.IsNull() {
+		maxDocuments = data.MaxDocuments.ValueInt64()
+	}
+	if maxDocuments < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_documents"), "Invalid Max Documents", "max_documents must be at least 1.")
+		return
+	}
+
+	name := d.prefix + data.Collection.ValueString()
+	documents, err := d.client.ExportDocuments(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export documents of collection %s: %s", name, err))
+		return
+	}
+	defer documents.Close()
+
+	jsonl, count, err := readJSONLDocuments(documents, maxDocuments)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Export Documents", fmt.Sprintf("Collection %s: %s", name, err))
+		return
+	}
+
+	data.JSONL = types.StringValue(jsonl)
+	data.DocumentCount = types.Int64Value(count)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readJSONLDocuments reads an export one line at a time and stops as soon as
+// it holds more than maxDocuments documents, so an oversized collection is
+// never read in full. Blank lines are skipped.
+func readJSONLDocuments(r io.Reader, maxDocuments int64) (string, int64, error) {
+	reader := bufio.NewReader(r)
+	var out strings.Builder
+	var count int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			count++
+			if count > maxDocuments {
+				return "", 0, fmt.Errorf("the collection has more than max_documents (%d) documents", maxDocuments)
+			}
+			out.Write(line)
+			out.WriteByte('\n')
+		}
+		if errors.Is(err, io.EOF) {
+			return out.String(), count, nil
+		}
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to read exported documents: %w", err)
+		}
+	}
+}
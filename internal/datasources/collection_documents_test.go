@@ -0,0 +1,52 @@
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionDocumentsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionDocumentsDataSourceConfig(rName, "max_documents = 10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_collection_documents.test", "collection", rName),
+					resource.TestCheckResourceAttr("data.typesense_collection_documents.test", "document_count", "0"),
+					resource.TestCheckResourceAttr("data.typesense_collection_documents.test", "jsonl", ""),
+				),
+			},
+			{
+				Config:      testAccCollectionDocumentsDataSourceConfig(rName, "max_documents = 0"),
+				ExpectError: regexp.MustCompile(`max_documents must be at least 1`),
+			},
+		},
+	})
+}
+
+func testAccCollectionDocumentsDataSourceConfig(name, maxDocuments string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+}
+
+data "typesense_collection_documents" "test" {
+  collection = typesense_collection.test.name
+  %[2]s
+}
+`, name, maxDocuments)
+}
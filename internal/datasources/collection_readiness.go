@@ -0,0 +1,126 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CollectionReadinessDataSource{}
+
+// NewCollectionReadinessDataSource creates a new collection readiness data source
+func NewCollectionReadinessDataSource() datasource.DataSource {
+	return &CollectionReadinessDataSource{}
+}
+
+// CollectionReadinessDataSource reports whether a collection holds a minimum
+// number of documents, for check blocks that gate on a populated index.
+type CollectionReadinessDataSource struct {
+	client *client.ServerClient
+	prefix string
+}
+
+// CollectionReadinessDataSourceModel describes the data source data model
+type CollectionReadinessDataSourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	MinDocuments types.Int64  `tfsdk:"min_documents"`
+	Exists       types.Bool   `tfsdk:"exists"`
+	NumDocuments types.Int64  `tfsdk:"num_documents"`
+	Ready        types.Bool   `tfsdk:"ready"`
+}
+
+func (d *CollectionReadinessDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceCollectionReadiness)
+}
+
+func (d *CollectionReadinessDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports whether a collection holds at least min_documents documents, e.g. for a check block that flags an index that has not been populated. " +
+			"A missing collection is reported as not ready instead of failing.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the collection. The provider's collection_name_prefix is added when talking to the server.",
+				Required:    true,
+			},
+			"min_documents": schema.Int64Attribute{
+				Description: "Number of documents the collection needs to be ready. Defaults to 1.",
+				Optional:    true,
+			},
+			"exists": schema.BoolAttribute{
+				Description: "Whether the collection exists.",
+				Computed:    true,
+			},
+			"num_documents": schema.Int64Attribute{
+				Description: "Number of documents in the collection; 0 if it does not exist.",
+				Computed:    true,
+			},
+			"ready": schema.BoolAttribute{
+				Description: "Whether the collection exists and num_documents is at least min_documents.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CollectionReadinessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read collections.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+	d.prefix = providerData.CollectionNamePrefix
+}
+
+func (d *CollectionReadinessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionReadinessDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minDocuments := int64(1)
+	if !data.MinDocuments.IsNull() {
+		minDocuments = data.MinDocuments.ValueInt64()
+	}
+
+	name := d.prefix + data.Name.ValueString()
+	collection, err := d.client.GetCollection(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read collection %s: %s", name, err))
+		return
+	}
+
+	var numDocuments int64
+	if collection != nil {
+		numDocuments = collection.NumDocuments
+	}
+	data.Exists = types.BoolValue(collection != nil)
+	data.NumDocuments = types.Int64Value(numDocuments)
+	data.Ready = types.BoolValue(collection != nil && numDocuments >= minDocuments)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
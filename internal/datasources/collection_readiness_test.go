@@ -0,0 +1,54 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionReadinessDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+}
+
+data "typesense_collection_readiness" "empty" {
+  name = typesense_collection.test.name
+}
+
+data "typesense_collection_readiness" "no_minimum" {
+  name          = typesense_collection.test.name
+  min_documents = 0
+}
+
+data "typesense_collection_readiness" "missing" {
+  name = "%[1]s-missing"
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_collection_readiness.empty", "exists", "true"),
+					resource.TestCheckResourceAttr("data.typesense_collection_readiness.empty", "num_documents", "0"),
+					resource.TestCheckResourceAttr("data.typesense_collection_readiness.empty", "ready", "false"),
+					resource.TestCheckResourceAttr("data.typesense_collection_readiness.no_minimum", "ready", "true"),
+					resource.TestCheckResourceAttr("data.typesense_collection_readiness.missing", "exists", "false"),
+					resource.TestCheckResourceAttr("data.typesense_collection_readiness.missing", "ready", "false"),
+				),
+			},
+		},
+	})
+}
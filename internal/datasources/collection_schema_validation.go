@@ -0,0 +1,226 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CollectionSchemaValidationDataSource{}
+
+// NewCollectionSchemaValidationDataSource creates a new collection schema validation data source
+func NewCollectionSchemaValidationDataSource() datasource.DataSource {
+	return &CollectionSchemaValidationDataSource{}
+}
+
+// CollectionSchemaValidationDataSource checks a collection schema against the
+// server without keeping a collection, e.g. in CI plan stages.
+type CollectionSchemaValidationDataSource struct {
+	client *client.ServerClient
+	prefix string
+}
+
+// CollectionSchemaValidationDataSourceModel describes the data source data model
+type CollectionSchemaValidationDataSourceModel struct {
+	Fields              []SchemaValidationFieldModel `tfsdk:"field"`
+	DefaultSortingField types.String                 `tfsdk:"default_sorting_field"`
+	TokenSeparators     types.List                   `tfsdk:"token_separators"`
+	SymbolsToIndex      types.List                   `tfsdk:"symbols_to_index"`
+	EnableNestedFields  types.Bool                   `tfsdk:"enable_nested_fields"`
+	Valid               types.Bool                   `tfsdk:"valid"`
+	Errors              types.List                   `tfsdk:"errors"`
+}
+
+// SchemaValidationFieldModel describes a field of the schema to validate. It
+// mirrors the field block of typesense_collection, without embed and
+// hnsw_params.
+type SchemaValidationFieldModel struct {
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Facet          types.Bool   `tfsdk:"facet"`
+	Optional       types.Bool   `tfsdk:"optional"`
+	Index          types.Bool   `tfsdk:"index"`
+	Sort           types.Bool   `tfsdk:"sort"`
+	Infix          types.Bool   `tfsdk:"infix"`
+	Locale         types.String `tfsdk:"locale"`
+	Stem           types.Bool   `tfsdk:"stem"`
+	Store          types.Bool   `tfsdk:"store"`
+	RangeIndex     types.Bool   `tfsdk:"range_index"`
+	NumDim         types.Int64  `tfsdk:"num_dim"`
+	VecDist        types.String `tfsdk:"vec_dist"`
+	Reference      types.String `tfsdk:"reference"`
+	AsyncReference types.Bool   `tfsdk:"async_reference"`
+}
+
+func (d *CollectionSchemaValidationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceCollectionSchemaValidation)
+}
+
+func (d *CollectionSchemaValidationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether the Typesense server accepts a collection schema, without keeping a collection. " +
+			"Typesense has no validation endpoint, so every read creates the schema under a temporary tf_schema_validation_ name, after the provider's collection_name_prefix, and deletes it right away.",
+		Attributes: map[string]schema.Attribute{
+			"field": schema.ListNestedAttribute{
+				Description: "Fields of the schema, as in the field blocks of typesense_collection (embed and hnsw_params are not supported).",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":            schema.StringAttribute{Description: "Name of the field.", Required: true},
+						"type":            schema.StringAttribute{Description: "Data type of the field.", Required: true},
+						"facet":           schema.BoolAttribute{Description: "Enable faceting on this field.", Optional: true},
+						"optional":        schema.BoolAttribute{Description: "Whether this field is optional.", Optional: true},
+						"index":           schema.BoolAttribute{Description: "Whether to index this field.", Optional: true},
+						"sort":            schema.BoolAttribute{Description: "Enable sorting on this field.", Optional: true},
+						"infix":           schema.BoolAttribute{Description: "Enable infix search on this field.", Optional: true},
+						"locale":          schema.StringAttribute{Description: "Locale for language-specific processing.", Optional: true},
+						"stem":            schema.BoolAttribute{Description: "Enable stemming on this field.", Optional: true},
+						"store":           schema.BoolAttribute{Description: "Whether to store the field value on disk.", Optional: true},
+						"range_index":     schema.BoolAttribute{Description: "Enable an index optimized for range filters.", Optional: true},
+						"num_dim":         schema.Int64Attribute{Description: "Number of dimensions of a vector field.", Optional: true},
+						"vec_dist":        schema.StringAttribute{Description: "Distance metric of a vector field (cosine or ip).", Optional: true},
+						"reference":       schema.StringAttribute{Description: "Reference to a field of another collection, e.g. \"authors.id\". The collection must exist. The provider's collection_name_prefix is added to the collection.", Optional: true},
+						"async_reference": schema.BoolAttribute{Description: "Allow documents to reference ones that do not exist yet.", Optional: true},
+					},
+				},
+			},
+			"default_sorting_field": schema.StringAttribute{
+				Description: "The default field to sort results by.",
+				Optional:    true,
+			},
+			"token_separators": schema.ListAttribute{
+				Description: "List of characters to use as token separators.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"symbols_to_index": schema.ListAttribute{
+				Description: "List of symbols to index.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"enable_nested_fields": schema.BoolAttribute{
+				Description: "Enable nested fields support.",
+				Optional:    true,
+			},
+			"valid": schema.BoolAttribute{
+				Description: "Whether the server accepted the schema.",
+				Computed:    true,
+			},
+			"errors": schema.ListAttribute{
+				Description: "The server's reasons for rejecting the schema. Empty when valid.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *CollectionSchemaValidationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to validate collection schemas.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+	d.prefix = providerData.CollectionNamePrefix
+}
+
+func (d *CollectionSchemaValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionSchemaValidationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection := &client.Collection{
+		DefaultSortingField: data.DefaultSortingField.ValueString(),
+		EnableNestedFields:  data.EnableNestedFields.ValueBool(),
+	}
+	resp.Diagnostics.Append(data.TokenSeparators.ElementsAs(ctx, &collection.TokenSeparators, false)...)
+	resp.Diagnostics.Append(data.SymbolsToIndex.ElementsAs(ctx, &collection.SymbolsToIndex, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, f := range data.Fields {
+		reference := f.Reference.ValueString()
+		if reference != "" {
+			reference = d.prefix + reference
+		}
+		collection.Fields = append(collection.Fields, client.CollectionField{
+			Name:           f.Name.ValueString(),
+			Type:           f.Type.ValueString(),
+			Facet:          f.Facet.ValueBool(),
+			Optional:       boolPointer(f.Optional),
+			Index:          boolPointer(f.Index),
+			Sort:           boolPointer(f.Sort),
+			Infix:          f.Infix.ValueBool(),
+			Locale:         f.Locale.ValueString(),
+			Stem:           boolPointer(f.Stem),
+			Store:          boolPointer(f.Store),
+			RangeIndex:     boolPointer(f.RangeIndex),
+			NumDim:         int64Pointer(f.NumDim),
+			VecDist:        f.VecDist.ValueString(),
+			Reference:      reference,
+			AsyncReference: boolPointer(f.AsyncReference),
+		})
+	}
+
+	problem, err := d.client.ValidateCollectionSchema(ctx, collection, d.prefix)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to validate collection schema: %s", err))
+		return
+	}
+
+	errs := []string{}
+	if problem != "" {
+		errs = append(errs, problem)
+	}
+	data.Valid = types.BoolValue(problem == "")
+	errorList, diags := types.ListValueFrom(ctx, types.StringType, errs)
+	resp.Diagnostics.Append(diags...)
+	data.Errors = errorList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// boolPointer returns nil for a null or unknown value, so the server default
+// applies, and a pointer to the value otherwise.
+func boolPointer(v types.Bool) *bool {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	b := v.ValueBool()
+	return &b
+}
+
+// int64Pointer is boolPointer for Int64 values.
+func int64Pointer(v types.Int64) *int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := v.ValueInt64()
+	return &i
+}
@@ -0,0 +1,43 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionSchemaValidationDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "typesense_collection_schema_validation" "valid" {
+  default_sorting_field = "rating"
+
+  field = [
+    { name = "title", type = "string" },
+    { name = "rating", type = "int32" },
+  ]
+}
+
+data "typesense_collection_schema_validation" "invalid" {
+  default_sorting_field = "missing"
+
+  field = [
+    { name = "title", type = "string" },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_collection_schema_validation.valid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.typesense_collection_schema_validation.valid", "errors.#", "0"),
+					resource.TestCheckResourceAttr("data.typesense_collection_schema_validation.invalid", "valid", "false"),
+					resource.TestCheckResourceAttr("data.typesense_collection_schema_validation.invalid", "errors.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package datasources_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionDataSource_schemaJSON(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_collection" "products" {
+  name                  = %[1]q
+  default_sorting_field = "rating"
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name = "rating"
+    type = "int32"
+  }
+}
+
+data "typesense_collection" "products" {
+  name = typesense_collection.products.name
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_collection.products", "name", rName),
+					resource.TestCheckResourceAttrWith("data.typesense_collection.products", "schema_json", func(value string) error {
+						var schema struct {
+							Name                string `json:"name"`
+							DefaultSortingField string `json:"default_sorting_field"`
+							Fields              []struct {
+								Name string `json:"name"`
+								Type string `json:"type"`
+							} `json:"fields"`
+						}
+						if err := json.Unmarshal([]byte(value), &schema); err != nil {
+							return fmt.Errorf("schema_json is not valid JSON: %w", err)
+						}
+						if schema.Name != rName || schema.DefaultSortingField != "rating" {
+							return fmt.Errorf("schema_json = %s, want collection %s sorted by rating", value, rName)
+						}
+						if len(schema.Fields) != 2 || schema.Fields[1].Name != "rating" || schema.Fields[1].Type != "int32" {
+							return fmt.Errorf("schema_json fields = %+v, want title and rating", schema.Fields)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ConversationModelsDataSource{}
+
+// NewConversationModelsDataSource creates a new conversation models data source
+func NewConversationModelsDataSource() datasource.DataSource {
+	return &ConversationModelsDataSource{}
+}
+
+// ConversationModelsDataSource defines the data source implementation
+type ConversationModelsDataSource struct {
+	client *client.ServerClient
+}
+
+// ConversationModelsDataSourceModel describes the data source data model
+type ConversationModelsDataSourceModel struct {
+	Models types.List `tfsdk:"models"`
+}
+
+func (d *ConversationModelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceConversationModels)
+}
+
+func (d *ConversationModelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all conversation models (RAG) on the Typesense server. Secret fields (API keys, account IDs) are never exposed.",
+		Attributes: map[string]schema.Attribute{
+			"models": schema.ListNestedAttribute{
+				Description: "List of conversation models.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier of the conversation model.",
+							Computed:    true,
+						},
+						"model_name": schema.StringAttribute{
+							Description: "The LLM model name (e.g., \"openai/gpt-4o\").",
+							Computed:    true,
+						},
+						"history_collection": schema.StringAttribute{
+							Description: "Collection that stores the conversation history.",
+							Computed:    true,
+						},
+						"system_prompt": schema.StringAttribute{
+							Description: "Instructions given to the LLM.",
+							Computed:    true,
+						},
+						"ttl": schema.Int64Attribute{
+							Description: "Time-to-live in seconds for conversation history messages.",
+							Computed:    true,
+						},
+						"max_bytes": schema.Int64Attribute{
+							Description: "Maximum payload size in bytes sent to the LLM per request.",
+							Computed:    true,
+						},
+						"vllm_url": schema.StringAttribute{
+							Description: "URL of the self-hosted vLLM deployment, if configured.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConversationModelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read conversation models.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *ConversationModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConversationModelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	models, err := d.client.ListConversationModels(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list conversation models: %s", err))
+		return
+	}
+
+	modelAttrTypes := map[string]attr.Type{
+		"id":                 types.StringType,
+		"model_name":         types.StringType,
+		"history_collection": types.StringType,
+		"system_prompt":      types.StringType,
+		"ttl":                types.Int64Type,
+		"max_bytes":          types.Int64Type,
+		"vllm_url":           types.StringType,
+	}
+
+	modelValues := make([]attr.Value, len(models))
+	for i, m := range models {
+		modelValues[i], _ = types.ObjectValue(modelAttrTypes, map[string]attr.Value{
+			"id":                 types.StringValue(m.ID),
+			"model_name":         types.StringValue(m.ModelName),
+			"history_collection": types.StringValue(m.HistoryCollection),
+			"system_prompt":      types.StringValue(m.SystemPrompt),
+			"ttl":                types.Int64Value(m.TTL),
+			"max_bytes":          types.Int64Value(m.MaxBytes),
+			"vllm_url":           optionalString(m.VllmURL),
+		})
+	}
+
+	modelObjType := types.ObjectType{AttrTypes: modelAttrTypes}
+	data.Models, _ = types.ListValue(modelObjType, modelValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,117 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DocumentDataSource{}
+
+// NewDocumentDataSource creates a new document data source
+func NewDocumentDataSource() datasource.DataSource {
+	return &DocumentDataSource{}
+}
+
+// DocumentDataSource reads one document by collection and ID, for records
+// such as feature flags that drive other parts of a configuration.
+type DocumentDataSource struct {
+	client *client.ServerClient
+	prefix string
+}
+
+// DocumentDataSourceModel describes the data source data model
+type DocumentDataSourceModel struct {
+	Collection types.String `tfsdk:"collection"`
+	ID         types.String `tfsdk:"id"`
+	Body       types.String `tfsdk:"body"`
+}
+
+func (d *DocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceDocument)
+}
+
+func (d *DocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a single document by collection and ID. Reading fails when the document does not exist.",
+		Attributes: map[string]schema.Attribute{
+			"collection": schema.StringAttribute{
+				Description: "The name of the collection holding the document. The provider's collection_name_prefix is added when talking to the server.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the document.",
+				Required:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "The document as a JSON object string; decode it with jsondecode().",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DocumentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read documents.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+	d.prefix = providerData.CollectionNamePrefix
+}
+
+func (d *DocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DocumentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection := d.prefix + data.Collection.ValueString()
+	id := data.ID.ValueString()
+
+	document, err := d.client.GetDocument(ctx, collection, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read document: %s", err))
+		return
+	}
+
+	if document == nil {
+		resp.Diagnostics.AddError("Document Not Found", fmt.Sprintf("No document with ID %q exists in collection %q.", id, collection))
+		return
+	}
+
+	body, err := json.Marshal(document)
+	if err != nil {
+		resp.Diagnostics.AddError("Serialization Error", fmt.Sprintf("Unable to encode document: %s", err))
+		return
+	}
+	data.Body = types.StringValue(string(body))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDocumentDataSource_notFound(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDocumentDataSourceConfig(rName, "missing"),
+				ExpectError: regexp.MustCompile(`No document with ID "missing" exists`),
+			},
+		},
+	})
+}
+
+func testAccDocumentDataSourceConfig(name, id string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "enabled"
+    type = "bool"
+  }
+}
+
+data "typesense_document" "test" {
+  collection = typesense_collection.test.name
+  id         = %[2]q
+}
+`, name, id)
+}
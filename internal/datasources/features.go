@@ -0,0 +1,95 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &FeaturesDataSource{}
+
+// NewFeaturesDataSource creates a new features data source
+func NewFeaturesDataSource() datasource.DataSource {
+	return &FeaturesDataSource{}
+}
+
+// FeaturesDataSource exposes the provider's feature matrix for the detected
+// server version, so configurations can use count or for_each to create
+// resources only where the server supports them. It has one boolean
+// attribute per version.Feature, named after the feature.
+type FeaturesDataSource struct {
+	featureChecker version.FeatureChecker
+}
+
+func (d *FeaturesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceFeatures)
+}
+
+func (d *FeaturesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"server_version": schema.StringAttribute{
+			Description: "The server version the features were checked against. Null when the version could not be detected, in which case every feature is false.",
+			Computed:    true,
+		},
+	}
+	for _, feature := range version.Features {
+		attributes[string(feature)] = schema.BoolAttribute{
+			Description: fmt.Sprintf("Whether the server supports %s.", feature),
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reports which version-dependent features the Typesense server supports, based on the server version detected (or pinned with server_version) when the provider is configured.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *FeaturesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read server features.",
+		)
+		return
+	}
+
+	d.featureChecker = providerData.FeatureChecker
+}
+
+func (d *FeaturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	checker := d.featureChecker
+	if checker == nil {
+		checker = version.NewFallbackFeatureChecker()
+	}
+
+	serverVersion := types.StringNull()
+	if v := checker.GetVersion(); v != nil {
+		serverVersion = types.StringValue(v.String())
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_version"), serverVersion)...)
+
+	for _, feature := range version.Features {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(string(feature)), checker.SupportsFeature(feature))...)
+	}
+}
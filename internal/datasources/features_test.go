@@ -0,0 +1,25 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeaturesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "typesense_features" "this" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.typesense_features.this", "server_version"),
+					resource.TestCheckResourceAttrSet("data.typesense_features.this", "synonym_sets"),
+					resource.TestCheckResourceAttrSet("data.typesense_features.this", "stopwords"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &HealthDataSource{}
+
+// healthPollInterval is how often Read asks /health again while waiting.
+const healthPollInterval = 2 * time.Second
+
+// NewHealthDataSource creates a new health data source
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+// HealthDataSource reports whether the server answers /health with ok, so
+// that resources can depend on a freshly provisioned server being ready.
+type HealthDataSource struct {
+	client *client.ServerClient
+}
+
+// HealthDataSourceModel describes the data source data model
+type HealthDataSourceModel struct {
+	WaitTimeoutSeconds types.Int64 `tfsdk:"wait_timeout_seconds"`
+	OK                 types.Bool  `tfsdk:"ok"`
+}
+
+func (d *HealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceHealth)
+}
+
+func (d *HealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports whether the Typesense server is healthy (/health). A 503, or an {\"ok\": false} response, is reported as ok = false; other error statuses, such as a 401 for a bad key, fail the read. " +
+			"With wait_timeout_seconds, the read waits for the server to become healthy, so resources that list it in depends_on are only created once it accepts requests.",
+		Attributes: map[string]schema.Attribute{
+			"wait_timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the server to become healthy, polling every 2 seconds. Connection errors and error statuses count as not healthy while waiting. " +
+					"When the time runs out, ok is false. By default the server is asked once and connection errors fail the read.",
+				Optional: true,
+			},
+			"ok": schema.BoolAttribute{
+				Description: "Whether the server reports itself healthy.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read server health.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HealthDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.WaitTimeoutSeconds.IsNull() && data.WaitTimeoutSeconds.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("wait_timeout_seconds"), "Invalid Wait Timeout", "wait_timeout_seconds must be at least 1.")
+		return
+	}
+
+	if data.WaitTimeoutSeconds.IsNull() {
+		healthy, err := d.client.GetHealth(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get server health: %s", err))
+			return
+		}
+		data.OK = types.BoolValue(healthy)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	deadline := time.Now().Add(time.Duration(data.WaitTimeoutSeconds.ValueInt64()) * time.Second)
+	healthy := false
+	for {
+		// Errors are expected while the server is starting; keep waiting.
+		if ok, err := d.client.GetHealth(ctx); err == nil && ok {
+			healthy = true
+			break
+		}
+		if time.Now().Add(healthPollInterval).After(deadline) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Stopped waiting for the server to become healthy: %s", ctx.Err()))
+			return
+		case <-time.After(healthPollInterval):
+		}
+	}
+
+	data.OK = types.BoolValue(healthy)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHealthDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "typesense_health" "this" {
+  wait_timeout_seconds = 30
+}
+
+resource "typesense_stopwords_set" "test" {
+  name      = "test-health-gated"
+  stopwords = ["the"]
+
+  depends_on = [data.typesense_health.this]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_health.this", "ok", "true"),
+					resource.TestCheckResourceAttr("typesense_stopwords_set.test", "name", "test-health-gated"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &MetricsDataSource{}
+
+// NewMetricsDataSource creates a new metrics data source
+func NewMetricsDataSource() datasource.DataSource {
+	return &MetricsDataSource{}
+}
+
+// MetricsDataSource defines the data source implementation
+type MetricsDataSource struct {
+	client *client.ServerClient
+}
+
+// MetricsDataSourceModel describes the data source data model
+type MetricsDataSourceModel struct {
+	Available                     types.Bool    `tfsdk:"available"`
+	SystemCPUActivePercentage     types.Float64 `tfsdk:"system_cpu_active_percentage"`
+	SystemMemoryUsedBytes         types.Int64   `tfsdk:"system_memory_used_bytes"`
+	SystemMemoryTotalBytes        types.Int64   `tfsdk:"system_memory_total_bytes"`
+	SystemDiskUsedBytes           types.Int64   `tfsdk:"system_disk_used_bytes"`
+	SystemDiskTotalBytes          types.Int64   `tfsdk:"system_disk_total_bytes"`
+	TypesenseMemoryActiveBytes    types.Int64   `tfsdk:"typesense_memory_active_bytes"`
+	TypesenseMemoryAllocatedBytes types.Int64   `tfsdk:"typesense_memory_allocated_bytes"`
+}
+
+func (d *MetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceMetrics)
+}
+
+func (d *MetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves CPU, memory and disk usage of the Typesense server (/metrics.json). " +
+			"Where the endpoint is restricted or missing, as on some hosted plans, available is false and the metrics are null.",
+		Attributes: map[string]schema.Attribute{
+			"available": schema.BoolAttribute{
+				Description: "Whether the server returned metrics.",
+				Computed:    true,
+			},
+			"system_cpu_active_percentage": schema.Float64Attribute{
+				Description: "CPU usage across all cores, in percent.",
+				Computed:    true,
+			},
+			"system_memory_used_bytes": schema.Int64Attribute{
+				Description: "Memory used on the host, in bytes.",
+				Computed:    true,
+			},
+			"system_memory_total_bytes": schema.Int64Attribute{
+				Description: "Total memory of the host, in bytes.",
+				Computed:    true,
+			},
+			"system_disk_used_bytes": schema.Int64Attribute{
+				Description: "Disk space used on the data directory's volume, in bytes.",
+				Computed:    true,
+			},
+			"system_disk_total_bytes": schema.Int64Attribute{
+				Description: "Total disk space of the data directory's volume, in bytes.",
+				Computed:    true,
+			},
+			"typesense_memory_active_bytes": schema.Int64Attribute{
+				Description: "Memory actively used by the Typesense process, in bytes.",
+				Computed:    true,
+			},
+			"typesense_memory_allocated_bytes": schema.Int64Attribute{
+				Description: "Memory allocated by the Typesense process, in bytes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *MetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read server metrics.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *MetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metrics, err := d.client.GetMetrics(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get server metrics: %s", err))
+		return
+	}
+
+	data.Available = types.BoolValue(metrics != nil)
+	data.SystemCPUActivePercentage = metricFloat(metrics, "system_cpu_active_percentage")
+	data.SystemMemoryUsedBytes = metricInt(metrics, "system_memory_used_bytes")
+	data.SystemMemoryTotalBytes = metricInt(metrics, "system_memory_total_bytes")
+	data.SystemDiskUsedBytes = metricInt(metrics, "system_disk_used_bytes")
+	data.SystemDiskTotalBytes = metricInt(metrics, "system_disk_total_bytes")
+	data.TypesenseMemoryActiveBytes = metricInt(metrics, "typesense_memory_active_bytes")
+	data.TypesenseMemoryAllocatedBytes = metricInt(metrics, "typesense_memory_allocated_bytes")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// metricNumber returns a metric from a /metrics.json response, which holds
+// numbers as strings. ok is false when the metric is missing or not a number.
+func metricNumber(metrics map[string]any, key string) (float64, bool) {
+	switch v := metrics[key].(type) {
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func metricFloat(metrics map[string]any, key string) types.Float64 {
+	v, ok := metricNumber(metrics, key)
+	if !ok {
+		return types.Float64Null()
+	}
+	return types.Float64Value(v)
+}
+
+func metricInt(metrics map[string]any, key string) types.Int64 {
+	v, ok := metricNumber(metrics, key)
+	if !ok {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(v))
+}
@@ -0,0 +1,26 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetricsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "typesense_metrics" "this" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_metrics.this", "available", "true"),
+					resource.TestCheckResourceAttrSet("data.typesense_metrics.this", "system_cpu_active_percentage"),
+					resource.TestCheckResourceAttrSet("data.typesense_metrics.this", "system_memory_used_bytes"),
+					resource.TestCheckResourceAttrSet("data.typesense_metrics.this", "typesense_memory_active_bytes"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,139 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &MultiSearchDataSource{}
+
+// NewMultiSearchDataSource creates a new multi search data source
+func NewMultiSearchDataSource() datasource.DataSource {
+	return &MultiSearchDataSource{}
+}
+
+// MultiSearchDataSource defines the data source implementation
+type MultiSearchDataSource struct {
+	client *client.ServerClient
+}
+
+// MultiSearchDataSourceModel describes the data source data model
+type MultiSearchDataSourceModel struct {
+	Searches     types.List `tfsdk:"searches"`
+	CommonParams types.Map  `tfsdk:"common_params"`
+	Found        types.List `tfsdk:"found"`
+}
+
+func (d *MultiSearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceMultiSearch)
+}
+
+func (d *MultiSearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a federated search through the Typesense /multi_search endpoint and returns the number of hits of each search. " +
+			"Useful to check in CI that the searches a frontend sends are accepted and find documents. Any search that fails is reported as an error.",
+		Attributes: map[string]schema.Attribute{
+			"searches": schema.ListAttribute{
+				Description: "The searches to run. Each is a map of Typesense search parameters, e.g. { collection = \"products\", q = \"shoe\", query_by = \"title\" }, sent as is.",
+				Required:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+			},
+			"common_params": schema.MapAttribute{
+				Description: "Search parameters applied to every search that does not set them itself, e.g. query_by or per_page.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"found": schema.ListAttribute{
+				Description: "The number of documents found by each search, in the order of searches.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+		},
+	}
+}
+
+func (d *MultiSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to run searches.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *MultiSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MultiSearchDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var searchParams []map[string]string
+	resp.Diagnostics.Append(data.Searches.ElementsAs(ctx, &searchParams, false)...)
+	commonParams := map[string]string{}
+	if !data.CommonParams.IsNull() {
+		resp.Diagnostics.Append(data.CommonParams.ElementsAs(ctx, &commonParams, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searches := make([]map[string]any, len(searchParams))
+	for i, params := range searchParams {
+		searches[i] = make(map[string]any, len(params))
+		for k, v := range params {
+			searches[i][k] = v
+		}
+	}
+
+	results, err := d.client.MultiSearch(ctx, searches, commonParams)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run multi search: %s", err))
+		return
+	}
+
+	found := make([]int64, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			resp.Diagnostics.AddError(
+				"Search Failed",
+				fmt.Sprintf("Search %d (collection %q) failed with status %d: %s", i, searchParams[i]["collection"], result.Code, result.Error),
+			)
+			continue
+		}
+		found[i] = result.Found
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	foundList, diags := types.ListValueFrom(ctx, types.Int64Type, found)
+	resp.Diagnostics.Append(diags...)
+	data.Found = foundList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
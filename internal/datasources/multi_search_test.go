@@ -0,0 +1,77 @@
+package datasources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMultiSearchDataSource_found(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiSearchCollectionConfig(rName),
+			},
+			{
+				PreConfig: func() {
+					docs := strings.NewReader(`{"id":"1","title":"running shoe"}
+{"id":"2","title":"hiking boot"}
+`)
+					if err := provider.TestAccServerClient().ImportDocuments(context.Background(), rName, docs, ""); err != nil {
+						t.Fatalf("failed to import documents: %s", err)
+					}
+				},
+				Config: testAccMultiSearchCollectionConfig(rName) + `
+data "typesense_multi_search" "test" {
+  searches = [
+    { collection = typesense_collection.test.name, q = "shoe" },
+    { collection = typesense_collection.test.name, q = "*" },
+  ]
+
+  common_params = {
+    query_by = "title"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_multi_search.test", "found.#", "2"),
+					resource.TestCheckResourceAttr("data.typesense_multi_search.test", "found.0", "1"),
+					resource.TestCheckResourceAttr("data.typesense_multi_search.test", "found.1", "2"),
+				),
+			},
+			{
+				Config: testAccMultiSearchCollectionConfig(rName) + `
+data "typesense_multi_search" "test" {
+  searches = [
+    { collection = typesense_collection.test.name, q = "shoe", query_by = "missing_field" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("Search Failed"),
+			},
+		},
+	})
+}
+
+func testAccMultiSearchCollectionConfig(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+}
+`, name)
+}
@@ -0,0 +1,171 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NLSearchModelsDataSource{}
+
+// NewNLSearchModelsDataSource creates a new NL search models data source
+func NewNLSearchModelsDataSource() datasource.DataSource {
+	return &NLSearchModelsDataSource{}
+}
+
+// NLSearchModelsDataSource defines the data source implementation
+type NLSearchModelsDataSource struct {
+	client *client.ServerClient
+}
+
+// NLSearchModelsDataSourceModel describes the data source data model
+type NLSearchModelsDataSourceModel struct {
+	Models types.List `tfsdk:"models"`
+}
+
+func (d *NLSearchModelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceNLSearchModels)
+}
+
+func (d *NLSearchModelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all natural language search models on the Typesense server. Secret fields (API keys, tokens, client secrets) are never exposed.",
+		Attributes: map[string]schema.Attribute{
+			"models": schema.ListNestedAttribute{
+				Description: "List of NL search models.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier of the NL search model.",
+							Computed:    true,
+						},
+						"model_name": schema.StringAttribute{
+							Description: "The LLM model name (e.g., \"openai/gpt-4o-mini\").",
+							Computed:    true,
+						},
+						"provider": schema.StringAttribute{
+							Description: "The LLM provider, derived from the model_name prefix (e.g., \"openai\", \"google\", \"cf\").",
+							Computed:    true,
+						},
+						"account_id": schema.StringAttribute{
+							Description: "Cloudflare account ID, if configured.",
+							Computed:    true,
+						},
+						"api_url": schema.StringAttribute{
+							Description: "Custom API URL for self-hosted vLLM models, if configured.",
+							Computed:    true,
+						},
+						"project_id": schema.StringAttribute{
+							Description: "GCP project ID for Vertex AI models, if configured.",
+							Computed:    true,
+						},
+						"max_bytes": schema.Int64Attribute{
+							Description: "Maximum payload size in bytes sent to the LLM.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NLSearchModelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read NL search models.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *NLSearchModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NLSearchModelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	models, err := d.client.ListNLSearchModels(ctx)
+	if client.IsVersionUnsupported(err) {
+		resp.Diagnostics.AddError("Unsupported Typesense Version", fmt.Sprintf("Unable to list NL search models: %s", err))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list NL search models: %s", err))
+		return
+	}
+
+	modelAttrTypes := map[string]attr.Type{
+		"id":         types.StringType,
+		"model_name": types.StringType,
+		"provider":   types.StringType,
+		"account_id": types.StringType,
+		"api_url":    types.StringType,
+		"project_id": types.StringType,
+		"max_bytes":  types.Int64Type,
+	}
+
+	modelValues := make([]attr.Value, len(models))
+	for i, m := range models {
+		modelValues[i], _ = types.ObjectValue(modelAttrTypes, map[string]attr.Value{
+			"id":         types.StringValue(m.ID),
+			"model_name": types.StringValue(m.ModelName),
+			"provider":   types.StringValue(nlModelProvider(m.ModelName)),
+			"account_id": optionalString(m.AccountID),
+			"api_url":    optionalString(m.APIURL),
+			"project_id": optionalString(m.ProjectID),
+			"max_bytes":  types.Int64Value(m.MaxBytes),
+		})
+	}
+
+	modelObjType := types.ObjectType{AttrTypes: modelAttrTypes}
+	data.Models, _ = types.ListValue(modelObjType, modelValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// nlModelProvider returns the provider prefix of a model name such as
+// "openai/gpt-4o-mini", or an empty string when the name has no prefix.
+func nlModelProvider(modelName string) string {
+	provider, _, found := strings.Cut(modelName, "/")
+	if !found {
+		return ""
+	}
+	return provider
+}
+
+// optionalString maps an empty API string to null so unset fields are
+// distinguishable from explicitly configured ones.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
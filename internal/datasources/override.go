@@ -0,0 +1,233 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OverrideDataSource{}
+
+// NewOverrideDataSource creates a new override data source
+func NewOverrideDataSource() datasource.DataSource {
+	return &OverrideDataSource{}
+}
+
+// OverrideDataSource reads one override by collection and name. Like the
+// typesense_override resource, it uses the per-collection overrides API up to
+// v29 and the curation set named after the collection from v30.
+type OverrideDataSource struct {
+	client         *client.ServerClient
+	featureChecker version.FeatureChecker
+	prefix         string
+}
+
+// OverrideDataSourceModel describes the data source data model
+type OverrideDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Collection types.String `tfsdk:"collection"`
+	Name       types.String `tfsdk:"name"`
+	Rule       types.Object `tfsdk:"rule"`
+	Includes   types.List   `tfsdk:"includes"`
+	Excludes   types.List   `tfsdk:"excludes"`
+	FilterBy   types.String `tfsdk:"filter_by"`
+	SortBy     types.String `tfsdk:"sort_by"`
+}
+
+var overrideRuleAttrTypes = map[string]attr.Type{
+	"query": types.StringType,
+	"match": types.StringType,
+	"tags":  types.ListType{ElemType: types.StringType},
+}
+
+var overrideIncludeAttrTypes = map[string]attr.Type{
+	"id":       types.StringType,
+	"position": types.Int64Type,
+}
+
+var overrideExcludeAttrTypes = map[string]attr.Type{
+	"id": types.StringType,
+}
+
+func (d *OverrideDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceOverride)
+}
+
+func (d *OverrideDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing override (curation rule) by collection and name. In Typesense v29 and earlier it is read from the collection's overrides; in v30+ from the curation set named after the collection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the override, in the form collection/name with the collection name on the server.",
+				Computed:    true,
+			},
+			"collection": schema.StringAttribute{
+				Description: "The name of the collection the override belongs to; in v30+, the curation set name. The provider's collection_name_prefix is added when talking to the server.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name (ID) of the override.",
+				Required:    true,
+			},
+			"rule": schema.SingleNestedAttribute{
+				Description: "The rule that triggers the override.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"query": schema.StringAttribute{
+						Description: "The query pattern to match.",
+						Computed:    true,
+					},
+					"match": schema.StringAttribute{
+						Description: "Match type: 'exact' or 'contains'.",
+						Computed:    true,
+					},
+					"tags": schema.ListAttribute{
+						Description: "Tags that trigger the override.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"includes": schema.ListNestedAttribute{
+				Description: "Documents pinned in results.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Document ID to include.",
+							Computed:    true,
+						},
+						"position": schema.Int64Attribute{
+							Description: "Position the document is pinned at (1-indexed).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"excludes": schema.ListNestedAttribute{
+				Description: "Documents excluded from results.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Document ID to exclude.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"filter_by": schema.StringAttribute{
+				Description: "Filter expression the override applies. Null when it has none.",
+				Computed:    true,
+			},
+			"sort_by": schema.StringAttribute{
+				Description: "Sort expression the override applies. Null when it has none.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *OverrideDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read overrides.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+	d.featureChecker = providerData.FeatureChecker
+	d.prefix = providerData.CollectionNamePrefix
+}
+
+func (d *OverrideDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OverrideDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection := d.prefix + data.Collection.ValueString()
+	name := data.Name.ValueString()
+
+	var override *client.Override
+	if d.featureChecker != nil && d.featureChecker.SupportsFeature(version.FeatureCurationSets) {
+		item, err := d.client.GetCurationSetItem(ctx, collection, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read override using v30+ curation sets API: %s", err))
+			return
+		}
+		if item != nil {
+			override = client.CurationItemToOverride(item)
+		}
+	} else {
+		var err error
+		override, err = d.client.GetOverride(ctx, collection, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read override using per-collection overrides API: %s", err))
+			return
+		}
+	}
+
+	if override == nil {
+		resp.Diagnostics.AddError("Override Not Found", fmt.Sprintf("No override named %q exists for collection %q.", name, collection))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", collection, name))
+	data.FilterBy = optionalString(override.FilterBy)
+	data.SortBy = optionalString(override.SortBy)
+
+	tags := types.ListNull(types.StringType)
+	if len(override.Rule.Tags) > 0 {
+		tags, _ = types.ListValueFrom(ctx, types.StringType, override.Rule.Tags)
+	}
+	data.Rule, _ = types.ObjectValue(overrideRuleAttrTypes, map[string]attr.Value{
+		"query": optionalString(override.Rule.Query),
+		"match": optionalString(override.Rule.Match),
+		"tags":  tags,
+	})
+
+	includeValues := make([]attr.Value, len(override.Includes))
+	for i, include := range override.Includes {
+		includeValues[i], _ = types.ObjectValue(overrideIncludeAttrTypes, map[string]attr.Value{
+			"id":       types.StringValue(include.ID),
+			"position": types.Int64Value(int64(include.Position)),
+		})
+	}
+	data.Includes, _ = types.ListValue(types.ObjectType{AttrTypes: overrideIncludeAttrTypes}, includeValues)
+
+	excludeValues := make([]attr.Value, len(override.Excludes))
+	for i, exclude := range override.Excludes {
+		excludeValues[i], _ = types.ObjectValue(overrideExcludeAttrTypes, map[string]attr.Value{
+			"id": types.StringValue(exclude.ID),
+		})
+	}
+	data.Excludes, _ = types.ListValue(types.ObjectType{AttrTypes: overrideExcludeAttrTypes}, excludeValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,110 @@
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccOverrideDataSource_basic reads an override back on whichever API the
+// server offers: per-collection overrides up to v29, curation sets from v30.
+func TestAccOverrideDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+	overrideName := acctest.RandomWithPrefix("test-override")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name  = "price"
+    type  = "float"
+    facet = true
+  }
+}
+
+resource "typesense_override" "test" {
+  collection = typesense_collection.test.name
+  name       = %[2]q
+  filter_by  = "price:<100"
+  sort_by    = "price:asc"
+
+  rule = {
+    query = "cheap"
+    match = "exact"
+  }
+
+  includes {
+    id       = "100"
+    position = 1
+  }
+
+  excludes {
+    id = "200"
+  }
+}
+
+data "typesense_override" "test" {
+  collection = typesense_override.test.collection
+  name       = typesense_override.test.name
+}
+`, rName, overrideName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_override.test", "id", rName+"/"+overrideName),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "rule.query", "cheap"),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "rule.match", "exact"),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "filter_by", "price:<100"),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "sort_by", "price:asc"),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "includes.#", "1"),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "includes.0.id", "100"),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "includes.0.position", "1"),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "excludes.#", "1"),
+					resource.TestCheckResourceAttr("data.typesense_override.test", "excludes.0.id", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOverrideDataSource_notFound(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+}
+
+data "typesense_override" "test" {
+  collection = typesense_collection.test.name
+  name       = "missing"
+}
+`, rName),
+				ExpectError: regexp.MustCompile(`Override Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,149 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &StatsDataSource{}
+
+// NewStatsDataSource creates a new stats data source
+func NewStatsDataSource() datasource.DataSource {
+	return &StatsDataSource{}
+}
+
+// StatsDataSource defines the data source implementation
+type StatsDataSource struct {
+	client *client.ServerClient
+}
+
+// StatsDataSourceModel describes the data source data model
+type StatsDataSourceModel struct {
+	Healthy                     types.Bool    `tfsdk:"healthy"`
+	TotalRequestsPerSecond      types.Float64 `tfsdk:"total_requests_per_second"`
+	SearchRequestsPerSecond     types.Float64 `tfsdk:"search_requests_per_second"`
+	WriteRequestsPerSecond      types.Float64 `tfsdk:"write_requests_per_second"`
+	SearchLatencyMs             types.Float64 `tfsdk:"search_latency_ms"`
+	WriteLatencyMs              types.Float64 `tfsdk:"write_latency_ms"`
+	PendingWriteBatches         types.Float64 `tfsdk:"pending_write_batches"`
+	OverloadedRequestsPerSecond types.Float64 `tfsdk:"overloaded_requests_per_second"`
+}
+
+func (d *StatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceStats)
+}
+
+func (d *StatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves health and request metrics from the Typesense server (/health and /stats.json). " +
+			"Reading /stats.json requires an admin key or a key allowed the \"stats.json:list\" action.",
+		Attributes: map[string]schema.Attribute{
+			"healthy": schema.BoolAttribute{
+				Description: "Whether the server reports itself healthy.",
+				Computed:    true,
+			},
+			"total_requests_per_second": schema.Float64Attribute{
+				Description: "Requests per second across all endpoints.",
+				Computed:    true,
+			},
+			"search_requests_per_second": schema.Float64Attribute{
+				Description: "Search requests per second.",
+				Computed:    true,
+			},
+			"write_requests_per_second": schema.Float64Attribute{
+				Description: "Write requests per second.",
+				Computed:    true,
+			},
+			"search_latency_ms": schema.Float64Attribute{
+				Description: "Average search latency in milliseconds.",
+				Computed:    true,
+			},
+			"write_latency_ms": schema.Float64Attribute{
+				Description: "Average write latency in milliseconds.",
+				Computed:    true,
+			},
+			"pending_write_batches": schema.Float64Attribute{
+				Description: "Number of write batches waiting to be applied.",
+				Computed:    true,
+			},
+			"overloaded_requests_per_second": schema.Float64Attribute{
+				Description: "Requests per second rejected because the server was overloaded.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *StatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read server stats.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *StatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	healthy, err := d.client.GetHealth(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get server health: %s", err))
+		return
+	}
+
+	stats, err := d.client.GetStats(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get server stats: %s", err))
+		return
+	}
+
+	data.Healthy = types.BoolValue(healthy)
+	data.TotalRequestsPerSecond = statValue(stats, "total_requests_per_second")
+	data.SearchRequestsPerSecond = statValue(stats, "search_requests_per_second")
+	data.WriteRequestsPerSecond = statValue(stats, "write_requests_per_second")
+	data.SearchLatencyMs = statValue(stats, "search_latency_ms")
+	data.WriteLatencyMs = statValue(stats, "write_latency_ms")
+	data.PendingWriteBatches = statValue(stats, "pending_write_batches")
+	data.OverloadedRequestsPerSecond = statValue(stats, "overloaded_requests_per_second")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// statValue returns a numeric metric from a /stats.json response, or null
+// when the server version does not report it.
+func statValue(stats map[string]any, key string) types.Float64 {
+	v, ok := stats[key].(float64)
+	if !ok {
+		return types.Float64Null()
+	}
+	return types.Float64Value(v)
+}
@@ -0,0 +1,25 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "typesense_stats" "current" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_stats.current", "healthy", "true"),
+					resource.TestCheckResourceAttrSet("data.typesense_stats.current", "total_requests_per_second"),
+					resource.TestCheckResourceAttrSet("data.typesense_stats.current", "search_latency_ms"),
+				),
+			},
+		},
+	})
+}
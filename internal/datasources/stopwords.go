@@ -0,0 +1,113 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &StopwordsDataSource{}
+
+// NewStopwordsDataSource creates a new stopwords data source
+func NewStopwordsDataSource() datasource.DataSource {
+	return &StopwordsDataSource{}
+}
+
+// StopwordsDataSource defines the data source implementation
+type StopwordsDataSource struct {
+	client *client.ServerClient
+}
+
+// StopwordsDataSourceModel describes the data source data model
+type StopwordsDataSourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	Stopwords types.Set    `tfsdk:"stopwords"`
+	Locale    types.String `tfsdk:"locale"`
+}
+
+func (d *StopwordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceStopwords)
+}
+
+func (d *StopwordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing Typesense stopwords set, e.g. one shared across collections.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The ID of the stopwords set.",
+				Required:    true,
+			},
+			"stopwords": schema.SetAttribute{
+				Description: "The words in the set.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"locale": schema.StringAttribute{
+				Description: "The locale of the set, if one is configured.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *StopwordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read stopwords sets.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *StopwordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StopwordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	set, err := d.client.GetStopwordsSet(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stopwords set %s: %s", name, err))
+		return
+	}
+	if set == nil {
+		resp.Diagnostics.AddError("Stopwords Set Not Found", fmt.Sprintf("No stopwords set with ID %q exists on the server.", name))
+		return
+	}
+
+	stopwords, diags := types.SetValueFrom(ctx, types.StringType, set.Stopwords)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Stopwords = stopwords
+	data.Locale = optionalString(set.Locale)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
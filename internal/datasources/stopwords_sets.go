@@ -0,0 +1,134 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &StopwordsSetsDataSource{}
+
+// NewStopwordsSetsDataSource creates a new stopwords sets data source
+func NewStopwordsSetsDataSource() datasource.DataSource {
+	return &StopwordsSetsDataSource{}
+}
+
+// StopwordsSetsDataSource lists every stopwords set on the server, e.g. for
+// checks that no set contains forbidden words.
+type StopwordsSetsDataSource struct {
+	client *client.ServerClient
+}
+
+// StopwordsSetsDataSourceModel describes the data source data model
+type StopwordsSetsDataSourceModel struct {
+	Sets types.List `tfsdk:"sets"`
+}
+
+var stopwordsSetAttrTypes = map[string]attr.Type{
+	"id":        types.StringType,
+	"stopwords": types.SetType{ElemType: types.StringType},
+	"locale":    types.StringType,
+}
+
+func (d *StopwordsSetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceStopwordsSets)
+}
+
+func (d *StopwordsSetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all stopwords sets on the Typesense server, sorted by ID.",
+		Attributes: map[string]schema.Attribute{
+			"sets": schema.ListNestedAttribute{
+				Description: "List of stopwords sets, sorted by ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the stopwords set.",
+							Computed:    true,
+						},
+						"stopwords": schema.SetAttribute{
+							Description: "The words in the set.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"locale": schema.StringAttribute{
+							Description: "The locale of the set, if one is configured.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StopwordsSetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read stopwords sets.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+}
+
+func (d *StopwordsSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StopwordsSetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sets, err := d.client.ListStopwordsSets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list stopwords sets: %s", err))
+		return
+	}
+
+	// The server's order is not guaranteed; sorting keeps plans stable.
+	sort.Slice(sets, func(i, j int) bool { return sets[i].ID < sets[j].ID })
+
+	setValues := make([]attr.Value, len(sets))
+	for i, set := range sets {
+		stopwords, diags := types.SetValueFrom(ctx, types.StringType, set.Stopwords)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		setValues[i], _ = types.ObjectValue(stopwordsSetAttrTypes, map[string]attr.Value{
+			"id":        types.StringValue(set.ID),
+			"stopwords": stopwords,
+			"locale":    optionalString(set.Locale),
+		})
+	}
+
+	data.Sets, _ = types.ListValue(types.ObjectType{AttrTypes: stopwordsSetAttrTypes}, setValues)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStopwordsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-stopwords")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_stopwords_set" "shared" {
+  name      = %[1]q
+  locale    = "en"
+  stopwords = ["the", "a", "an"]
+}
+
+data "typesense_stopwords" "shared" {
+  name = typesense_stopwords_set.shared.name
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_stopwords.shared", "name", rName),
+					resource.TestCheckResourceAttr("data.typesense_stopwords.shared", "locale", "en"),
+					resource.TestCheckResourceAttr("data.typesense_stopwords.shared", "stopwords.#", "3"),
+					resource.TestCheckTypeSetElemAttr("data.typesense_stopwords.shared", "stopwords.*", "the"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStopwordsSetsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-stopwords-sets")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_stopwords_set" "b" {
+  name      = "%[1]s-b"
+  stopwords = ["the", "a"]
+}
+
+resource "typesense_stopwords_set" "a" {
+  name      = "%[1]s-a"
+  locale    = "en"
+  stopwords = ["an"]
+}
+
+data "typesense_stopwords_sets" "all" {
+  depends_on = [typesense_stopwords_set.a, typesense_stopwords_set.b]
+}
+`, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.typesense_stopwords_sets.all", "sets.*", map[string]string{
+						"id":          rName + "-a",
+						"locale":      "en",
+						"stopwords.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.typesense_stopwords_sets.all", "sets.*", map[string]string{
+						"id":          rName + "-b",
+						"stopwords.#": "2",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SynonymDataSource{}
+
+// NewSynonymDataSource creates a new synonym data source
+func NewSynonymDataSource() datasource.DataSource {
+	return &SynonymDataSource{}
+}
+
+// SynonymDataSource reads one synonym by collection and name. Like the
+// typesense_synonym resource, it uses the per-collection synonyms API up to
+// v29 and the synonym set named after the collection from v30.
+type SynonymDataSource struct {
+	client         *client.ServerClient
+	featureChecker version.FeatureChecker
+	prefix         string
+}
+
+// SynonymDataSourceModel describes the data source data model
+type SynonymDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Collection types.String `tfsdk:"collection"`
+	Name       types.String `tfsdk:"name"`
+	Root       types.String `tfsdk:"root"`
+	Synonyms   types.List   `tfsdk:"synonyms"`
+}
+
+func (d *SynonymDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.DataSourceSynonym)
+}
+
+func (d *SynonymDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing synonym by collection and name. In Typesense v29 and earlier it is read from the collection's synonyms; in v30+ from the synonym set named after the collection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the synonym, in the form collection/name with the collection name on the server.",
+				Computed:    true,
+			},
+			"collection": schema.StringAttribute{
+				Description: "The name of the collection the synonym belongs to; in v30+, the synonym set name. The provider's collection_name_prefix is added when talking to the server.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name (ID) of the synonym.",
+				Required:    true,
+			},
+			"root": schema.StringAttribute{
+				Description: "Root word of a one-way synonym. Null for multi-way synonyms.",
+				Computed:    true,
+			},
+			"synonyms": schema.ListAttribute{
+				Description: "The words of the synonym.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SynonymDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to read synonyms.",
+		)
+		return
+	}
+
+	d.client = providerData.ServerClient
+	d.featureChecker = providerData.FeatureChecker
+	d.prefix = providerData.CollectionNamePrefix
+}
+
+func (d *SynonymDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SynonymDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collection := d.prefix + data.Collection.ValueString()
+	name := data.Name.ValueString()
+
+	var root string
+	var synonyms []string
+	var found bool
+	if d.featureChecker != nil && d.featureChecker.SupportsFeature(version.FeatureSynonymSets) {
+		item, err := d.client.GetSynonymSetItem(ctx, collection, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read synonym using v30+ synonym sets API: %s", err))
+			return
+		}
+		if item != nil {
+			found, root, synonyms = true, item.Root, item.Synonyms
+		}
+	} else {
+		synonym, err := d.client.GetSynonym(ctx, collection, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read synonym using per-collection synonyms API: %s", err))
+			return
+		}
+		if synonym != nil {
+			found, root, synonyms = true, synonym.Root, synonym.Synonyms
+		}
+	}
+
+	if !found {
+		resp.Diagnostics.AddError("Synonym Not Found", fmt.Sprintf("No synonym named %q exists for collection %q.", name, collection))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", collection, name))
+	data.Root = optionalString(root)
+	synonymList, diags := types.ListValueFrom(ctx, types.StringType, synonyms)
+	resp.Diagnostics.Append(diags...)
+	data.Synonyms = synonymList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,55 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSynonymDataSource_basic reads a synonym back on whichever API the
+// server offers: per-collection synonyms up to v29, synonym sets from v30.
+func TestAccSynonymDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+	synonymName := acctest.RandomWithPrefix("test-synonym")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+}
+
+resource "typesense_synonym" "test" {
+  collection = typesense_collection.test.name
+  name       = %[2]q
+  root       = "pants"
+  synonyms   = ["trousers", "jeans"]
+}
+
+data "typesense_synonym" "test" {
+  collection = typesense_synonym.test.collection
+  name       = typesense_synonym.test.name
+}
+`, rName, synonymName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.typesense_synonym.test", "id", rName+"/"+synonymName),
+					resource.TestCheckResourceAttr("data.typesense_synonym.test", "root", "pants"),
+					resource.TestCheckResourceAttr("data.typesense_synonym.test", "synonyms.#", "2"),
+					resource.TestCheckResourceAttr("data.typesense_synonym.test", "synonyms.0", "trousers"),
+					resource.TestCheckResourceAttr("data.typesense_synonym.test", "synonyms.1", "jeans"),
+				),
+			},
+		},
+	})
+}
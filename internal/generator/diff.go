@@ -0,0 +1,547 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+// ChangeAction says what applying a configuration would do to a resource.
+type ChangeAction string
+
+const (
+	// ChangeCreate marks a configured resource that is not on the server.
+	ChangeCreate ChangeAction = "create"
+	// ChangeUpdate marks a resource whose configured attributes differ from the server.
+	ChangeUpdate ChangeAction = "update"
+	// ChangeDelete marks a resource on the server that is not in the configuration.
+	ChangeDelete ChangeAction = "delete"
+)
+
+// ResourceChange is a resource that differs between the server and a configuration.
+type ResourceChange struct {
+	Action ChangeAction
+	// Address is the resource address in the configuration, or the address
+	// generate would give a resource that only exists on the server.
+	Address string
+	// ID identifies the resource on the server: its import ID, or the
+	// description for API keys, whose IDs are assigned by the server.
+	ID         string
+	Attributes []AttributeChange
+}
+
+// AttributeChange is an attribute whose configured value differs from the server.
+// Values are rendered as HCL; an empty value means the attribute is not set.
+type AttributeChange struct {
+	Path   string
+	Server string
+	Config string
+}
+
+// DiffResult holds the outcome of comparing the server with a configuration.
+type DiffResult struct {
+	Changes []ResourceChange
+	// Skipped lists configured resources that could not be compared, with the reason.
+	Skipped []string
+}
+
+// diffSchema describes how diff matches and compares one resource type.
+type diffSchema struct {
+	// identity names the attributes that identify a resource on the server.
+	identity []string
+	// ignored names attributes the server does not report: secrets and
+	// settings that only change how the provider behaves.
+	ignored []string
+	// sets names list attributes whose order does not matter.
+	sets []string
+}
+
+var diffSchemas = map[string]diffSchema{
+	tfnames.ResourceCluster: {identity: []string{"name"}},
+	tfnames.ResourceCollection: {
+		identity: []string{"name"},
+		// generate leaves metadata out, so it cannot be compared either.
+		ignored: []string{"force_destroy", "truncate_on_destroy", "rename_via_reindex", "reindex_on_embed_change", "schema_from", "skip_document_count", "metadata", "api_key"},
+	},
+	tfnames.ResourceCollectionAlias:    {identity: []string{"name"}},
+	tfnames.ResourceStopwordsSet:       {identity: []string{"name"}, sets: []string{"stopwords"}},
+	tfnames.ResourceSynonym:            {identity: []string{"collection", "name"}},
+	tfnames.ResourceOverride:           {identity: []string{"collection", "name"}},
+	tfnames.ResourcePreset:             {identity: []string{"name"}},
+	tfnames.ResourceAnalyticsRule:      {identity: []string{"name"}},
+	tfnames.ResourceAPIKey:             {identity: []string{"description"}, ignored: []string{"value", "autodelete"}},
+	tfnames.ResourceStemmingDictionary: {identity: []string{"dictionary_id"}},
+	tfnames.ResourceNLSearchModel:      {identity: []string{"id"}, ignored: []string{"api_key"}},
+	tfnames.ResourceConversationModel:  {identity: []string{"id"}, ignored: []string{"api_key"}},
+}
+
+// omittedDefaults holds the non-zero defaults generate leaves out. Zero
+// values (false, "", 0 and empty lists) are left out as well.
+var omittedDefaults = map[string]cty.Value{
+	"index": cty.True,
+}
+
+// diffFunctions are the Terraform functions configurations commonly use
+// for literal values; any other function call makes a value unresolvable.
+var diffFunctions = map[string]function.Function{
+	"jsonencode": stdlib.JSONEncodeFunc,
+	"jsondecode": stdlib.JSONDecodeFunc,
+}
+
+// metaArguments are Terraform's own resource arguments.
+var metaArguments = map[string]bool{
+	"count":      true,
+	"for_each":   true,
+	"depends_on": true,
+	"provider":   true,
+	"lifecycle":  true,
+}
+
+// Diff compares the resources on the server with the Terraform configuration
+// in configDir. Only attributes set in the configuration are compared, and
+// only resource types the generator can read with the configured clients.
+func (g *Generator) Diff(ctx context.Context, configDir string) (*DiffResult, error) {
+	configFiles, err := readConfigDir(configDir)
+	if err != nil {
+		return nil, err
+	}
+
+	fs, _, err := g.render(ctx)
+	if err != nil {
+		return nil, err
+	}
+	liveFiles := make(map[string][]byte, len(fs.files))
+	for name, f := range fs.files {
+		liveFiles[name] = f.Bytes()
+	}
+
+	types := make(map[string]bool)
+	for name := range diffSchemas {
+		if name == tfnames.ResourceCluster {
+			types[name] = g.cloudClient != nil && g.includes(name)
+		} else {
+			types[name] = g.serverClient != nil && g.includes(name)
+		}
+	}
+
+	return diffConfigs(liveFiles, configFiles, types)
+}
+
+// readConfigDir reads the .tf files in dir, without descending into modules.
+func readConfigDir(dir string) (map[string][]byte, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list configuration files: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no .tf files found in %s", dir)
+	}
+
+	files := make(map[string][]byte, len(paths))
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		files[path] = content
+	}
+	return files, nil
+}
+
+// diffResource is a resource block of a type diff knows how to compare.
+type diffResource struct {
+	typ    string
+	name   string
+	schema diffSchema
+	body   *hclsyntax.Body
+}
+
+func (r diffResource) address() string {
+	return tfnames.FullTypeName(r.typ) + "." + r.name
+}
+
+// identity evaluates the resource's identity attributes, joined like an import ID.
+func (r diffResource) identity(evalCtx *hcl.EvalContext) (string, bool) {
+	parts := make([]string, len(r.schema.identity))
+	for i, name := range r.schema.identity {
+		attr, ok := r.body.Attributes[name]
+		if !ok {
+			return "", false
+		}
+		value, diags := attr.Expr.Value(evalCtx)
+		if diags.HasErrors() || !value.IsWhollyKnown() || value.IsNull() {
+			return "", false
+		}
+		value, err := convert.Convert(value, cty.String)
+		if err != nil {
+			return "", false
+		}
+		parts[i] = value.AsString()
+	}
+	return strings.Join(parts, "/"), true
+}
+
+// parseDiffResources parses the given files and returns their resource
+// blocks, in file name order.
+func parseDiffResources(files map[string][]byte) ([]diffResource, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	schemas := make(map[string]string, len(diffSchemas))
+	for name := range diffSchemas {
+		schemas[tfnames.FullTypeName(name)] = name
+	}
+
+	var resources []diffResource
+	for _, name := range names {
+		file, diags := hclsyntax.ParseConfig(files[name], name, hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("failed to parse %s: %s", name, diags.Error())
+		}
+		for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+			if block.Type != "resource" || len(block.Labels) != 2 {
+				continue
+			}
+			typ, ok := schemas[block.Labels[0]]
+			if !ok {
+				continue
+			}
+			resources = append(resources, diffResource{
+				typ:    typ,
+				name:   block.Labels[1],
+				schema: diffSchemas[typ],
+				body:   block.Body,
+			})
+		}
+	}
+	return resources, nil
+}
+
+// diffEvalContext lets attributes refer to literal attributes of other
+// resources in the same configuration, e.g. typesense_collection.products.name.
+func diffEvalContext(resources []diffResource) *hcl.EvalContext {
+	literalCtx := &hcl.EvalContext{Functions: diffFunctions}
+
+	byType := make(map[string]map[string]cty.Value)
+	for _, r := range resources {
+		attrs := make(map[string]cty.Value)
+		for name, attr := range r.body.Attributes {
+			if value, diags := attr.Expr.Value(literalCtx); !diags.HasErrors() {
+				attrs[name] = value
+			}
+		}
+		typeName := tfnames.FullTypeName(r.typ)
+		if byType[typeName] == nil {
+			byType[typeName] = make(map[string]cty.Value)
+		}
+		byType[typeName][r.name] = cty.ObjectVal(attrs)
+	}
+
+	variables := make(map[string]cty.Value, len(byType))
+	for typeName, byName := range byType {
+		variables[typeName] = cty.ObjectVal(byName)
+	}
+	return &hcl.EvalContext{Variables: variables, Functions: diffFunctions}
+}
+
+// diffConfigs compares the generated configuration of the live server with a
+// user's configuration, for the resource types enabled in types.
+func diffConfigs(liveFiles, configFiles map[string][]byte, types map[string]bool) (*DiffResult, error) {
+	liveResources, err := parseDiffResources(liveFiles)
+	if err != nil {
+		return nil, err
+	}
+	configResources, err := parseDiffResources(configFiles)
+	if err != nil {
+		return nil, err
+	}
+	liveCtx := diffEvalContext(liveResources)
+	configCtx := diffEvalContext(configResources)
+
+	result := &DiffResult{}
+
+	live := make(map[string]diffResource)
+	for _, r := range liveResources {
+		if !types[r.typ] {
+			continue
+		}
+		if id, ok := r.identity(liveCtx); ok {
+			live[r.typ+"\x00"+id] = r
+		}
+	}
+
+	matched := make(map[string]bool)
+	for _, r := range configResources {
+		if !types[r.typ] {
+			continue
+		}
+		if r.body.Attributes["count"] != nil || r.body.Attributes["for_each"] != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: count and for_each are not supported", r.address()))
+			continue
+		}
+		id, ok := r.identity(configCtx)
+		if !ok {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %s cannot be resolved without running Terraform", r.address(), strings.Join(r.schema.identity, " and ")))
+			continue
+		}
+
+		key := r.typ + "\x00" + id
+		if matched[key] {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: another resource in the configuration manages %q", r.address(), id))
+			continue
+		}
+		matched[key] = true
+
+		server, ok := live[key]
+		if !ok {
+			result.Changes = append(result.Changes, ResourceChange{Action: ChangeCreate, Address: r.address(), ID: id})
+			continue
+		}
+		if changes := compareBodies("", r.body, server.body, configCtx, liveCtx, r.schema); len(changes) > 0 {
+			result.Changes = append(result.Changes, ResourceChange{Action: ChangeUpdate, Address: r.address(), ID: id, Attributes: changes})
+		}
+	}
+
+	for key, r := range live {
+		if !matched[key] {
+			result.Changes = append(result.Changes, ResourceChange{Action: ChangeDelete, Address: r.address(), ID: key[strings.IndexByte(key, 0)+1:]})
+		}
+	}
+
+	sort.SliceStable(result.Changes, func(i, j int) bool {
+		return result.Changes[i].Address < result.Changes[j].Address
+	})
+	return result, nil
+}
+
+// compareBodies compares the attributes and nested blocks set in a configured
+// body with the server's. Blocks of a type the configuration does not use are
+// not compared; blocks with a name attribute are matched by name.
+func compareBodies(prefix string, config, server *hclsyntax.Body, configCtx, serverCtx *hcl.EvalContext, schema diffSchema) []AttributeChange {
+	var changes []AttributeChange
+
+	names := make([]string, 0, len(config.Attributes))
+	for name := range config.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if metaArguments[name] || slices.Contains(schema.ignored, name) {
+			continue
+		}
+		configValue, diags := config.Attributes[name].Expr.Value(configCtx)
+		if diags.HasErrors() || !configValue.IsWhollyKnown() {
+			continue
+		}
+		serverValue := cty.NilVal
+		if attr, ok := server.Attributes[name]; ok {
+			value, diags := attr.Expr.Value(serverCtx)
+			if diags.HasErrors() {
+				continue
+			}
+			serverValue = value
+		}
+		changes = append(changes, compareValues(prefix+name, name, configValue, serverValue, schema)...)
+	}
+
+	configBlocks := blocksByType(config.Blocks)
+	serverBlocks := blocksByType(server.Blocks)
+	blockTypes := make([]string, 0, len(configBlocks))
+	for blockType := range configBlocks {
+		if !metaArguments[blockType] && blockType != "dynamic" {
+			blockTypes = append(blockTypes, blockType)
+		}
+	}
+	sort.Strings(blockTypes)
+
+	for _, blockType := range blockTypes {
+		configKeys, configByKey := keyBlocks(configBlocks[blockType], configCtx)
+		serverKeys, serverByKey := keyBlocks(serverBlocks[blockType], serverCtx)
+
+		for _, key := range configKeys {
+			path := fmt.Sprintf("%s%s[%s]", prefix, blockType, key)
+			serverBlock, ok := serverByKey[key]
+			if !ok {
+				changes = append(changes, AttributeChange{Path: path, Config: "(block)"})
+				continue
+			}
+			changes = append(changes, compareBodies(path+".", configByKey[key].Body, serverBlock.Body, configCtx, serverCtx, schema)...)
+		}
+		for _, key := range serverKeys {
+			if _, ok := configByKey[key]; !ok {
+				changes = append(changes, AttributeChange{Path: fmt.Sprintf("%s%s[%s]", prefix, blockType, key), Server: "(block)"})
+			}
+		}
+	}
+
+	return changes
+}
+
+func blocksByType(blocks hclsyntax.Blocks) map[string][]*hclsyntax.Block {
+	byType := make(map[string][]*hclsyntax.Block)
+	for _, block := range blocks {
+		byType[block.Type] = append(byType[block.Type], block)
+	}
+	return byType
+}
+
+// keyBlocks keys blocks by their quoted name attribute, or by position when
+// any of them has no literal name.
+func keyBlocks(blocks []*hclsyntax.Block, evalCtx *hcl.EvalContext) ([]string, map[string]*hclsyntax.Block) {
+	keys := make([]string, len(blocks))
+	for i, block := range blocks {
+		attr, ok := block.Body.Attributes["name"]
+		if !ok {
+			keys = nil
+			break
+		}
+		value, diags := attr.Expr.Value(evalCtx)
+		if diags.HasErrors() || value.IsNull() || !value.IsKnown() || value.Type() != cty.String {
+			keys = nil
+			break
+		}
+		keys[i] = fmt.Sprintf("%q", value.AsString())
+	}
+	if keys == nil {
+		keys = make([]string, len(blocks))
+		for i := range blocks {
+			keys[i] = fmt.Sprint(i)
+		}
+	}
+
+	byKey := make(map[string]*hclsyntax.Block, len(blocks))
+	for i, block := range blocks {
+		byKey[keys[i]] = block
+	}
+	return keys, byKey
+}
+
+// compareValues compares a configured value with the server's, which is
+// cty.NilVal when generate left the attribute out.
+func compareValues(path, name string, config, server cty.Value, schema diffSchema) []AttributeChange {
+	if config.IsNull() {
+		return nil
+	}
+
+	if server == cty.NilVal || server.IsNull() {
+		if isZeroValue(config) {
+			return nil
+		}
+		if def, ok := omittedDefaults[name]; ok && config.Equals(def).True() {
+			return nil
+		}
+		return []AttributeChange{{Path: path, Config: formatValue(config)}}
+	}
+
+	configType, serverType := config.Type(), server.Type()
+	switch {
+	case configType.IsObjectType() && serverType.IsObjectType():
+		var changes []AttributeChange
+		attrNames := make([]string, 0, len(configType.AttributeTypes()))
+		for attrName := range configType.AttributeTypes() {
+			attrNames = append(attrNames, attrName)
+		}
+		sort.Strings(attrNames)
+		for _, attrName := range attrNames {
+			if slices.Contains(schema.ignored, attrName) {
+				continue
+			}
+			serverAttr := cty.NilVal
+			if serverType.HasAttribute(attrName) {
+				serverAttr = server.GetAttr(attrName)
+			}
+			changes = append(changes, compareValues(path+"."+attrName, attrName, config.GetAttr(attrName), serverAttr, schema)...)
+		}
+		return changes
+
+	case configType.IsTupleType() && serverType.IsTupleType() && slices.Contains(schema.sets, name):
+		if sameElements(config, server) {
+			return nil
+		}
+
+	case configType.IsTupleType() && serverType.IsTupleType() && config.LengthInt() == server.LengthInt():
+		var changes []AttributeChange
+		for i := 0; i < config.LengthInt(); i++ {
+			index := cty.NumberIntVal(int64(i))
+			changes = append(changes, compareValues(fmt.Sprintf("%s[%d]", path, i), name, config.Index(index), server.Index(index), schema)...)
+		}
+		return changes
+
+	case configType == cty.String && serverType == cty.String:
+		if config.AsString() == server.AsString() || sameJSON(config.AsString(), server.AsString()) {
+			return nil
+		}
+
+	case configType.Equals(serverType):
+		if config.Equals(server).True() {
+			return nil
+		}
+	}
+
+	return []AttributeChange{{Path: path, Server: formatValue(server), Config: formatValue(config)}}
+}
+
+// isZeroValue reports whether v is false, "", 0 or empty.
+func isZeroValue(v cty.Value) bool {
+	switch {
+	case v.Type() == cty.Bool:
+		return v.False()
+	case v.Type() == cty.String:
+		return v.AsString() == ""
+	case v.Type() == cty.Number:
+		return v.Equals(cty.Zero).True()
+	case v.CanIterateElements():
+		return v.LengthInt() == 0
+	}
+	return false
+}
+
+// sameElements compares two tuples ignoring element order.
+func sameElements(a, b cty.Value) bool {
+	if a.LengthInt() != b.LengthInt() {
+		return false
+	}
+	formatted := func(v cty.Value) []string {
+		var elements []string
+		for it := v.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			elements = append(elements, formatValue(element))
+		}
+		sort.Strings(elements)
+		return elements
+	}
+	return reflect.DeepEqual(formatted(a), formatted(b))
+}
+
+// sameJSON reports whether two strings hold equal JSON documents, so
+// jsonencode output matches the server's formatting.
+func sameJSON(a, b string) bool {
+	var decodedA, decodedB interface{}
+	if json.Unmarshal([]byte(a), &decodedA) != nil || json.Unmarshal([]byte(b), &decodedB) != nil {
+		return false
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
+func formatValue(v cty.Value) string {
+	return strings.TrimSpace(string(hclwrite.TokensForValue(v).Bytes()))
+}
@@ -0,0 +1,177 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+)
+
+var allDiffTypes = func() map[string]bool {
+	types := make(map[string]bool)
+	for name := range diffSchemas {
+		types[name] = true
+	}
+	return types
+}()
+
+func TestDiffConfigsReportsCreateUpdateAndDelete(t *testing.T) {
+	optional := true
+	collection := &client.Collection{
+		Name: "products",
+		Fields: []client.CollectionField{
+			{Name: "title", Type: "string"},
+			{Name: "price", Type: "float", Optional: &optional},
+		},
+	}
+	live := map[string][]byte{
+		"collections.tf": generateCollectionBlock(collection, "products").BuildTokens(nil).Bytes(),
+		"presets.tf":     generatePresetBlock(&client.Preset{Name: "listing", Value: map[string]interface{}{"q": "*"}}, "listing").BuildTokens(nil).Bytes(),
+		"stopwords.tf":   generateStopwordsBlock(&client.StopwordsSet{ID: "common", Stopwords: []string{"the", "a"}}, "common").BuildTokens(nil).Bytes(),
+	}
+	config := map[string][]byte{
+		"main.tf": []byte(`
+variable "prefix" {}
+
+resource "typesense_collection" "catalog" {
+  name = "products"
+
+  field {
+    name  = "title"
+    type  = "string"
+    facet = true
+    index = true
+  }
+
+  field {
+    name     = "price"
+    type     = "float"
+    optional = true
+  }
+
+  force_destroy = true
+}
+
+resource "typesense_stopwords_set" "common" {
+  name      = "common"
+  stopwords = ["a", "the"]
+}
+
+resource "typesense_synonym" "shoes" {
+  collection = typesense_collection.catalog.name
+  name       = "shoes"
+  synonyms   = ["shoe", "sneaker"]
+}
+
+resource "typesense_preset" "templated" {
+  name  = "${var.prefix}-listing"
+  value = jsonencode({ q = "*" })
+}
+`),
+	}
+
+	result, err := diffConfigs(live, config, allDiffTypes)
+	if err != nil {
+		t.Fatalf("diffConfigs() returned error: %v", err)
+	}
+
+	want := []ResourceChange{
+		{
+			Action:  ChangeUpdate,
+			Address: "typesense_collection.catalog",
+			ID:      "products",
+			Attributes: []AttributeChange{
+				{Path: `field["title"].facet`, Config: "true"},
+			},
+		},
+		{Action: ChangeDelete, Address: "typesense_preset.listing", ID: "listing"},
+		{Action: ChangeCreate, Address: "typesense_synonym.shoes", ID: "products/shoes"},
+	}
+	if !reflect.DeepEqual(result.Changes, want) {
+		t.Errorf("changes = %+v, want %+v", result.Changes, want)
+	}
+	if wantSkipped := []string{"typesense_preset.templated: name cannot be resolved without running Terraform"}; !reflect.DeepEqual(result.Skipped, wantSkipped) {
+		t.Errorf("skipped = %q, want %q", result.Skipped, wantSkipped)
+	}
+}
+
+func TestDiffConfigsComparesChangedValuesAndBlocks(t *testing.T) {
+	collection := &client.Collection{
+		Name:   "products",
+		Fields: []client.CollectionField{{Name: "title", Type: "string"}, {Name: "legacy", Type: "int32"}},
+	}
+	live := map[string][]byte{
+		"collections.tf": generateCollectionBlock(collection, "products").BuildTokens(nil).Bytes(),
+	}
+	config := map[string][]byte{
+		"main.tf": []byte(`
+resource "typesense_collection" "products" {
+  name = "products"
+
+  field {
+    name = "title"
+    type = "string[]"
+  }
+
+  field {
+    name = "brand"
+    type = "string"
+  }
+}
+`),
+	}
+
+	result, err := diffConfigs(live, config, allDiffTypes)
+	if err != nil {
+		t.Fatalf("diffConfigs() returned error: %v", err)
+	}
+
+	want := []AttributeChange{
+		{Path: `field["title"].type`, Server: `"string"`, Config: `"string[]"`},
+		{Path: `field["brand"]`, Config: "(block)"},
+		{Path: `field["legacy"]`, Server: "(block)"},
+	}
+	if len(result.Changes) != 1 || !reflect.DeepEqual(result.Changes[0].Attributes, want) {
+		t.Errorf("changes = %+v, want one update with %+v", result.Changes, want)
+	}
+}
+
+func TestDiffConfigsSkipsTypesThatWereNotRead(t *testing.T) {
+	config := map[string][]byte{
+		"main.tf": []byte(`
+resource "typesense_cluster" "main" {
+  name   = "main"
+  memory = "0.5_gb"
+}
+`),
+	}
+
+	result, err := diffConfigs(nil, config, map[string]bool{tfnames.ResourceCollection: true})
+	if err != nil {
+		t.Fatalf("diffConfigs() returned error: %v", err)
+	}
+	if len(result.Changes) != 0 {
+		t.Errorf("changes = %+v, want none for a type that was not read", result.Changes)
+	}
+}
+
+func TestReadConfigDirRequiresTerraformFiles(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := readConfigDir(dir); err == nil {
+		t.Fatal("readConfigDir() on an empty directory returned no error")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "main.tf"), []byte(`resource "typesense_preset" "p" {}`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	files, err := readConfigDir(dir)
+	if err != nil {
+		t.Fatalf("readConfigDir() returned error: %v", err)
+	}
+	if len(files) != 1 {
+		t.Errorf("readConfigDir() read %d files, want 1", len(files))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -35,6 +36,11 @@ type Config struct {
 
 	// Data export settings
 	IncludeData bool
+
+	// Resource type selection, using the names in tfnames.GeneratedResourceNames.
+	// An empty Include selects every type; Exclude removes types from that.
+	Include []string
+	Exclude []string
 }
 
 // Generator handles the Terraform configuration generation
@@ -90,6 +96,14 @@ func (g *Generator) DetectServerVersion(ctx context.Context) error {
 	return nil
 }
 
+// includes reports whether resources of the given type are generated.
+func (g *Generator) includes(resourceType string) bool {
+	if len(g.config.Include) > 0 && !slices.Contains(g.config.Include, resourceType) {
+		return false
+	}
+	return !slices.Contains(g.config.Exclude, resourceType)
+}
+
 // fileSet manages multiple HCL output files, collapsing to a single file when SingleFile mode is enabled.
 type fileSet struct {
 	files      map[string]*hclwrite.File
@@ -124,6 +138,38 @@ func (g *Generator) Generate(ctx context.Context) error {
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
+	fs, importCommands, err := g.render(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Write all non-empty files
+	for name, f := range fs.files {
+		content := f.Bytes()
+		if name != "main.tf" && len(bytes.TrimSpace(content)) == 0 {
+			continue
+		}
+		filePath := filepath.Join(g.config.OutputDir, name)
+		if err := os.WriteFile(filePath, content, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	// Write imports.tf with HCL import blocks (Terraform 1.5+)
+	if len(importCommands) > 0 {
+		importsPath := filepath.Join(g.config.OutputDir, "imports.tf")
+		importFile := GenerateImportBlocks(importCommands)
+		if err := os.WriteFile(importsPath, importFile.Bytes(), 0644); err != nil {
+			return fmt.Errorf("failed to write imports.tf: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// render reads all resources and builds the Terraform configuration in
+// memory, along with the import commands for the generated resources.
+func (g *Generator) render(ctx context.Context) (*fileSet, []ImportCommand, error) {
 	fs := newFileSet(g.config.SingleFile)
 
 	// Main file: header comment + terraform block + provider block
@@ -146,81 +192,82 @@ func (g *Generator) Generate(ctx context.Context) error {
 	var importCommands []ImportCommand
 
 	// Generate cloud clusters if cloud client is available
-	if g.cloudClient != nil {
+	if g.cloudClient != nil && g.includes(tfnames.ResourceCluster) {
 		if err := g.generateClusters(ctx, fs.get("cluster.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate clusters: %w", err)
+			return nil, nil, fmt.Errorf("failed to generate clusters: %w", err)
 		}
 	}
 
 	// Generate server resources if server client is available
 	if g.serverClient != nil {
-		if err := g.generateCollections(ctx, fs.get("collections.tf"), resourceNames, collectionResourceMap, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate collections: %w", err)
-		}
-
-		if err := g.generateCollectionAliases(ctx, fs.get("aliases.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate collection aliases: %w", err)
-		}
-
-		if err := g.generateStopwords(ctx, fs.get("stopwords.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate stopwords: %w", err)
+		if g.includes(tfnames.ResourceCollection) {
+			if err := g.generateCollections(ctx, fs.get("collections.tf"), resourceNames, collectionResourceMap, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate collections: %w", err)
+			}
 		}
 
-		if err := g.generateStemmingDictionaries(ctx, fs.get("stemming.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate stemming dictionaries: %w", err)
+		if g.includes(tfnames.ResourceCollectionAlias) {
+			if err := g.generateCollectionAliases(ctx, fs.get("aliases.tf"), resourceNames, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate collection aliases: %w", err)
+			}
 		}
 
-		if err := g.generateSynonyms(ctx, fs.get("synonyms.tf"), resourceNames, collectionResourceMap, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate synonyms: %w", err)
+		if g.includes(tfnames.ResourceStopwordsSet) {
+			if err := g.generateStopwords(ctx, fs.get("stopwords.tf"), resourceNames, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate stopwords: %w", err)
+			}
 		}
 
-		if err := g.generateOverrides(ctx, fs.get("overrides.tf"), resourceNames, collectionResourceMap, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate overrides: %w", err)
+		if g.includes(tfnames.ResourceStemmingDictionary) {
+			if err := g.generateStemmingDictionaries(ctx, fs.get("stemming.tf"), resourceNames, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate stemming dictionaries: %w", err)
+			}
 		}
 
-		if err := g.generatePresets(ctx, fs.get("presets.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate presets: %w", err)
+		if g.includes(tfnames.ResourceSynonym) {
+			if err := g.generateSynonyms(ctx, fs.get("synonyms.tf"), resourceNames, collectionResourceMap, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate synonyms: %w", err)
+			}
 		}
 
-		if err := g.generateAnalyticsRules(ctx, fs.get("analytics.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate analytics rules: %w", err)
+		if g.includes(tfnames.ResourceOverride) {
+			if err := g.generateOverrides(ctx, fs.get("overrides.tf"), resourceNames, collectionResourceMap, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate overrides: %w", err)
+			}
 		}
 
-		if err := g.generateAPIKeys(ctx, fs.get("api_keys.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate API keys: %w", err)
+		if g.includes(tfnames.ResourcePreset) {
+			if err := g.generatePresets(ctx, fs.get("presets.tf"), resourceNames, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate presets: %w", err)
+			}
 		}
 
-		if err := g.generateNLSearchModels(ctx, fs.get("nl_search_models.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate NL search models: %w", err)
+		if g.includes(tfnames.ResourceAnalyticsRule) {
+			if err := g.generateAnalyticsRules(ctx, fs.get("analytics.tf"), resourceNames, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate analytics rules: %w", err)
+			}
 		}
 
-		if err := g.generateConversationModels(ctx, fs.get("conversation_models.tf"), resourceNames, &importCommands); err != nil {
-			return fmt.Errorf("failed to generate conversation models: %w", err)
+		if g.includes(tfnames.ResourceAPIKey) {
+			if err := g.generateAPIKeys(ctx, fs.get("api_keys.tf"), resourceNames, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate API keys: %w", err)
+			}
 		}
-	}
 
-	// Write all non-empty files
-	for name, f := range fs.files {
-		content := f.Bytes()
-		if name != "main.tf" && len(bytes.TrimSpace(content)) == 0 {
-			continue
-		}
-		filePath := filepath.Join(g.config.OutputDir, name)
-		if err := os.WriteFile(filePath, content, 0644); err != nil {
-			return fmt.Errorf("failed to write %s: %w", name, err)
+		if g.includes(tfnames.ResourceNLSearchModel) {
+			if err := g.generateNLSearchModels(ctx, fs.get("nl_search_models.tf"), resourceNames, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate NL search models: %w", err)
+			}
 		}
-	}
 
-	// Write imports.tf with HCL import blocks (Terraform 1.5+)
-	if len(importCommands) > 0 {
-		importsPath := filepath.Join(g.config.OutputDir, "imports.tf")
-		importFile := GenerateImportBlocks(importCommands)
-		if err := os.WriteFile(importsPath, importFile.Bytes(), 0644); err != nil {
-			return fmt.Errorf("failed to write imports.tf: %w", err)
+		if g.includes(tfnames.ResourceConversationModel) {
+			if err := g.generateConversationModels(ctx, fs.get("conversation_models.tf"), resourceNames, &importCommands); err != nil {
+				return nil, nil, fmt.Errorf("failed to generate conversation models: %w", err)
+			}
 		}
 	}
 
-	return nil
+	return fs, importCommands, nil
 }
 
 // clusterMatchesHost checks if a cluster's hostnames match the given server host.
@@ -608,7 +655,7 @@ func (g *Generator) generateStemmingDictionaries(ctx context.Context, f *hclwrit
 func (g *Generator) generateSynonyms(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, collectionResourceMap map[string]string, importCommands *[]ImportCommand) error {
 	// Use version-aware API selection
 	if g.featureChecker.SupportsFeature(version.FeatureSynonymSets) {
-		return g.generateSynonymSetsV30(ctx, f, resourceNames, importCommands)
+		return g.generateSynonymSetsV30(ctx, f, resourceNames, collectionResourceMap, importCommands)
 	}
 
 	// For v29 and earlier, or when version detection failed (fallback)
@@ -617,8 +664,11 @@ func (g *Generator) generateSynonyms(ctx context.Context, f *hclwrite.File, reso
 }
 
 // generateSynonymSetsV30 handles synonym generation for Typesense v30.0+ using the /synonym_sets API
-func (g *Generator) generateSynonymSetsV30(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, importCommands *[]ImportCommand) error {
+func (g *Generator) generateSynonymSetsV30(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, collectionResourceMap map[string]string, importCommands *[]ImportCommand) error {
 	synonymSets, err := g.serverClient.ListSynonymSets(ctx)
+	if client.IsVersionUnsupported(err) {
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to list synonym sets: %w", err)
 	}
@@ -636,7 +686,7 @@ func (g *Generator) generateSynonymSetsV30(ctx context.Context, f *hclwrite.File
 		{Type: 4, Bytes: []byte(fmt.Sprintf("# ============================================\n# SYNONYM SETS (Typesense v30.0+)%s\n# Note: Synonym sets are now system-level, not per-collection\n# ============================================\n\n", versionStr))},
 	})
 
-	g.appendSynonymSetResources(f, synonymSets, resourceNames, importCommands)
+	g.appendSynonymSetResources(f, synonymSets, resourceNames, collectionResourceMap, importCommands)
 
 	return nil
 }
@@ -673,7 +723,7 @@ func (g *Generator) generatePerCollectionSynonyms(ctx context.Context, f *hclwri
 	if len(allSynonyms) == 0 {
 		// If version detection failed and we got no synonyms, try the v30 API as fallback
 		if g.serverVersion == nil {
-			return g.generateSynonymSetsV30Fallback(ctx, f, resourceNames, importCommands)
+			return g.generateSynonymSetsV30Fallback(ctx, f, resourceNames, collectionResourceMap, importCommands)
 		}
 		return nil
 	}
@@ -688,9 +738,13 @@ func (g *Generator) generatePerCollectionSynonyms(ctx context.Context, f *hclwri
 	})
 
 	for _, item := range allSynonyms {
-		collectionResourceName := collectionResourceMap[item.collectionName]
 		resourceName := MakeUniqueResourceName(item.collectionName+"_"+item.synonym.ID, resourceNames)
-		block := generateSynonymBlock(&item.synonym, collectionResourceName, resourceName)
+		var block *hclwrite.Block
+		if collectionResourceName, ok := collectionResourceMap[item.collectionName]; ok {
+			block = generateSynonymBlock(&item.synonym, collectionResourceName, resourceName)
+		} else {
+			block = generateSynonymBlockWithCollectionLiteral(&item.synonym, item.collectionName, resourceName)
+		}
 		f.Body().AppendBlock(block)
 		f.Body().AppendNewline()
 
@@ -706,7 +760,7 @@ func (g *Generator) generatePerCollectionSynonyms(ctx context.Context, f *hclwri
 
 // generateSynonymSetsV30Fallback tries the v30 API when version detection failed
 // and per-collection synonyms returned nothing
-func (g *Generator) generateSynonymSetsV30Fallback(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, importCommands *[]ImportCommand) error {
+func (g *Generator) generateSynonymSetsV30Fallback(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, collectionResourceMap map[string]string, importCommands *[]ImportCommand) error {
 	synonymSets, err := g.serverClient.ListSynonymSets(ctx)
 	if err != nil || synonymSets == nil || len(synonymSets) == 0 {
 		// Either failed or no synonym sets - that's fine
@@ -718,37 +772,64 @@ func (g *Generator) generateSynonymSetsV30Fallback(ctx context.Context, f *hclwr
 		{Type: 4, Bytes: []byte("# ============================================\n# SYNONYM SETS (Typesense v30.0+)\n# Note: Synonym sets are now system-level, not per-collection\n# ============================================\n\n")},
 	})
 
-	g.appendSynonymSetResources(f, synonymSets, resourceNames, importCommands)
+	g.appendSynonymSetResources(f, synonymSets, resourceNames, collectionResourceMap, importCommands)
 
 	return nil
 }
 
-func (g *Generator) appendSynonymSetResources(f *hclwrite.File, synonymSets []client.SynonymSet, resourceNames map[string]bool, importCommands *[]ImportCommand) {
+// setSynonym is a synonym flattened out of a v30 synonym set, keeping the set
+// name so the generated resource can reference the collection it belongs to.
+type setSynonym struct {
+	synonym client.Synonym
+	setName string
+}
+
+// flattenSynonymSets converts v30 synonym sets into per-item synonyms.
+func flattenSynonymSets(synonymSets []client.SynonymSet) []setSynonym {
+	var flat []setSynonym
 	for _, synSet := range synonymSets {
 		for _, item := range synSet.Synonyms {
-			synonym := &client.Synonym{
-				ID:       item.ID,
-				Root:     item.Root,
-				Synonyms: item.Synonyms,
-			}
-			resourceName := MakeUniqueResourceName(synSet.Name+"_"+item.ID, resourceNames)
-			block := generateSynonymBlockWithCollectionLiteral(synonym, synSet.Name, resourceName)
-			f.Body().AppendBlock(block)
-			f.Body().AppendNewline()
-
-			*importCommands = append(*importCommands, ImportCommand{
-				ResourceType: tfnames.FullTypeName(tfnames.ResourceSynonym),
-				ResourceName: resourceName,
-				ImportID:     SynonymImportID(synSet.Name, item.ID),
+			flat = append(flat, setSynonym{
+				synonym: client.Synonym{
+					ID:       item.ID,
+					Root:     item.Root,
+					Synonyms: item.Synonyms,
+				},
+				setName: synSet.Name,
 			})
 		}
 	}
+	return flat
+}
+
+// appendSynonymSetResources emits a synonym resource per set item. The
+// provider names a collection's set after the collection, so items of a set
+// matching a generated collection reference that collection resource; other
+// sets are written as a literal name.
+func (g *Generator) appendSynonymSetResources(f *hclwrite.File, synonymSets []client.SynonymSet, resourceNames map[string]bool, collectionResourceMap map[string]string, importCommands *[]ImportCommand) {
+	for _, item := range flattenSynonymSets(synonymSets) {
+		resourceName := MakeUniqueResourceName(item.setName+"_"+item.synonym.ID, resourceNames)
+		var block *hclwrite.Block
+		if collectionResourceName, ok := collectionResourceMap[item.setName]; ok {
+			block = generateSynonymBlock(&item.synonym, collectionResourceName, resourceName)
+		} else {
+			block = generateSynonymBlockWithCollectionLiteral(&item.synonym, item.setName, resourceName)
+		}
+		f.Body().AppendBlock(block)
+		f.Body().AppendNewline()
+
+		*importCommands = append(*importCommands, ImportCommand{
+			ResourceType: tfnames.FullTypeName(tfnames.ResourceSynonym),
+			ResourceName: resourceName,
+			ImportID:     SynonymImportID(item.setName, item.synonym.ID),
+		})
+	}
 }
 
 func (g *Generator) generateOverrides(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, collectionResourceMap map[string]string, importCommands *[]ImportCommand) error {
 	// Use version-aware API selection
 	if g.featureChecker.SupportsFeature(version.FeatureCurationSets) {
-		return g.generateCurationSetsV30(ctx, f, resourceNames, importCommands)
+		return g.generateCurationSetsV30(ctx, f, resourceNames, collectionResourceMap, importCommands)
 	}
 
 	// For v29 and earlier, or when version detection failed (fallback)
@@ -756,8 +837,11 @@ func (g *Generator) generateOverrides(ctx context.Context, f *hclwrite.File, res
 }
 
 // generateCurationSetsV30 handles override generation for Typesense v30.0+ using the /curation_sets API
-func (g *Generator) generateCurationSetsV30(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, importCommands *[]ImportCommand) error {
+func (g *Generator) generateCurationSetsV30(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, collectionResourceMap map[string]string, importCommands *[]ImportCommand) error {
 	curationSets, err := g.serverClient.ListCurationSets(ctx)
+	if client.IsVersionUnsupported(err) {
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to list curation sets: %w", err)
 	}
@@ -775,7 +859,7 @@ func (g *Generator) generateCurationSetsV30(ctx context.Context, f *hclwrite.Fil
 		{Type: 4, Bytes: []byte(fmt.Sprintf("# ============================================\n# CURATION SETS (Typesense v30.0+)%s\n# Note: Curation sets (formerly overrides) are now system-level, not per-collection\n# ============================================\n\n", versionStr))},
 	})
 
-	g.appendCurationSetResources(f, curationSets, resourceNames, importCommands)
+	g.appendCurationSetResources(f, curationSets, resourceNames, collectionResourceMap, importCommands)
 
 	return nil
 }
@@ -812,7 +896,7 @@ func (g *Generator) generatePerCollectionOverrides(ctx context.Context, f *hclwr
 	if len(allOverrides) == 0 {
 		// If version detection failed and we got no overrides, try the v30 API as fallback
 		if g.serverVersion == nil {
-			return g.generateCurationSetsV30Fallback(ctx, f, resourceNames, importCommands)
+			return g.generateCurationSetsV30Fallback(ctx, f, resourceNames, collectionResourceMap, importCommands)
 		}
 		return nil
 	}
@@ -827,9 +911,13 @@ func (g *Generator) generatePerCollectionOverrides(ctx context.Context, f *hclwr
 	})
 
 	for _, item := range allOverrides {
-		collectionResourceName := collectionResourceMap[item.collectionName]
 		resourceName := MakeUniqueResourceName(item.collectionName+"_"+item.override.ID, resourceNames)
-		block := generateOverrideBlock(&item.override, collectionResourceName, resourceName)
+		var block *hclwrite.Block
+		if collectionResourceName, ok := collectionResourceMap[item.collectionName]; ok {
+			block = generateOverrideBlock(&item.override, collectionResourceName, resourceName)
+		} else {
+			block = generateOverrideBlockWithCollectionLiteral(&item.override, item.collectionName, resourceName)
+		}
 		f.Body().AppendBlock(block)
 		f.Body().AppendNewline()
 
@@ -845,7 +933,7 @@ func (g *Generator) generatePerCollectionOverrides(ctx context.Context, f *hclwr
 
 // generateCurationSetsV30Fallback tries the v30 API when version detection failed
 // and per-collection overrides returned nothing
-func (g *Generator) generateCurationSetsV30Fallback(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, importCommands *[]ImportCommand) error {
+func (g *Generator) generateCurationSetsV30Fallback(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, collectionResourceMap map[string]string, importCommands *[]ImportCommand) error {
 	curationSets, err := g.serverClient.ListCurationSets(ctx)
 	if err != nil || curationSets == nil || len(curationSets) == 0 {
 		// Either failed or no curation sets - that's fine
@@ -857,7 +945,7 @@ func (g *Generator) generateCurationSetsV30Fallback(ctx context.Context, f *hclw
 		{Type: 4, Bytes: []byte("# ============================================\n# CURATION SETS (Typesense v30.0+)\n# Note: Curation sets (formerly overrides) are now system-level, not per-collection\n# ============================================\n\n")},
 	})
 
-	g.appendCurationSetResources(f, curationSets, resourceNames, importCommands)
+	g.appendCurationSetResources(f, curationSets, resourceNames, collectionResourceMap, importCommands)
 
 	return nil
 }
@@ -892,42 +980,46 @@ func (g *Generator) generatePresets(ctx context.Context, f *hclwrite.File, resou
 	return nil
 }
 
-func (g *Generator) appendCurationSetResources(f *hclwrite.File, curationSets []client.CurationSet, resourceNames map[string]bool, importCommands *[]ImportCommand) {
+// setOverride is an override flattened out of a v30 curation set, keeping the
+// set name so the generated resource can reference the collection it belongs to.
+type setOverride struct {
+	override client.Override
+	setName  string
+}
+
+// flattenCurationSets converts v30 curation sets into per-item overrides.
+func flattenCurationSets(curationSets []client.CurationSet) []setOverride {
+	var flat []setOverride
 	for _, curSet := range curationSets {
 		for _, item := range curSet.Curations {
-			override := curationItemToOverride(&item)
-			resourceName := MakeUniqueResourceName(curSet.Name+"_"+item.ID, resourceNames)
-			block := generateOverrideBlockWithCollectionLiteral(override, curSet.Name, resourceName)
-			f.Body().AppendBlock(block)
-			f.Body().AppendNewline()
-
-			*importCommands = append(*importCommands, ImportCommand{
-				ResourceType: tfnames.FullTypeName(tfnames.ResourceOverride),
-				ResourceName: resourceName,
-				ImportID:     OverrideImportID(curSet.Name, item.ID),
+			flat = append(flat, setOverride{
+				override: *client.CurationItemToOverride(&item),
+				setName:  curSet.Name,
 			})
 		}
 	}
+	return flat
 }
 
-func curationItemToOverride(c *client.CurationItem) *client.Override {
-	rmt := false
-	if c.RemoveMatchedTokens != nil {
-		rmt = *c.RemoveMatchedTokens
-	}
-	return &client.Override{
-		ID:                  c.ID,
-		Rule:                c.Rule,
-		Includes:            c.Includes,
-		Excludes:            c.Excludes,
-		FilterBy:            c.FilterBy,
-		SortBy:              c.SortBy,
-		ReplaceQuery:        c.ReplaceQuery,
-		RemoveMatchedTokens: rmt,
-		FilterCuratedHits:   c.FilterCuratedHits,
-		EffectiveFromTs:     c.EffectiveFromTs,
-		EffectiveToTs:       c.EffectiveToTs,
-		StopProcessing:      c.StopProcessing,
+// appendCurationSetResources emits an override resource per set item,
+// referencing the collection resource named like the set when there is one.
+func (g *Generator) appendCurationSetResources(f *hclwrite.File, curationSets []client.CurationSet, resourceNames map[string]bool, collectionResourceMap map[string]string, importCommands *[]ImportCommand) {
+	for _, item := range flattenCurationSets(curationSets) {
+		resourceName := MakeUniqueResourceName(item.setName+"_"+item.override.ID, resourceNames)
+		var block *hclwrite.Block
+		if collectionResourceName, ok := collectionResourceMap[item.setName]; ok {
+			block = generateOverrideBlock(&item.override, collectionResourceName, resourceName)
+		} else {
+			block = generateOverrideBlockWithCollectionLiteral(&item.override, item.setName, resourceName)
+		}
+		f.Body().AppendBlock(block)
+		f.Body().AppendNewline()
+
+		*importCommands = append(*importCommands, ImportCommand{
+			ResourceType: tfnames.FullTypeName(tfnames.ResourceOverride),
+			ResourceName: resourceName,
+			ImportID:     OverrideImportID(item.setName, item.override.ID),
+		})
 	}
 }
 
@@ -1003,6 +1095,9 @@ func (g *Generator) generateAPIKeys(ctx context.Context, f *hclwrite.File, resou
 
 func (g *Generator) generateNLSearchModels(ctx context.Context, f *hclwrite.File, resourceNames map[string]bool, importCommands *[]ImportCommand) error {
 	models, err := g.serverClient.ListNLSearchModels(ctx)
+	if client.IsVersionUnsupported(err) {
+		return nil
+	}
 	if err != nil {
 		// NL search models may not be available on all server versions
 		fmt.Fprintf(os.Stderr, "Warning: Could not list NL search models: %v\n", err)
@@ -154,6 +154,41 @@ func TestGenerateSynonymSetsV30EmitsImportableSynonymResources(t *testing.T) {
 	}
 }
 
+func TestGenerateSynonymSetsV30ReferencesCollectionResource(t *testing.T) {
+	g, cleanup := newGeneratorForTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"name":"products-v2","items":[{"id":"shoe terms","synonyms":["shoe","sneaker"]}]},
+			{"name":"shared","items":[{"id":"tv","synonyms":["tv","television"]}]}
+		]`))
+	})
+	defer cleanup()
+
+	g.serverVersion = version.MustParse("30.0")
+	g.featureChecker = version.NewFeatureChecker(g.serverVersion)
+
+	f := hclwrite.NewEmptyFile()
+	resourceNames := make(map[string]bool)
+	collectionResourceMap := map[string]string{"products-v2": "products_v2"}
+	var importCommands []ImportCommand
+
+	if err := g.generateSynonyms(context.Background(), f, resourceNames, collectionResourceMap, &importCommands); err != nil {
+		t.Fatalf("generateSynonyms() returned error: %v", err)
+	}
+
+	hcl := string(f.Bytes())
+	collectionRef := tfnames.FullTypeName(tfnames.ResourceCollection) + ".products_v2.name"
+	if !strings.Contains(hcl, collectionRef) {
+		t.Fatalf("generated HCL did not reference the collection resource with %q:\n%s", collectionRef, hcl)
+	}
+	if !strings.Contains(hcl, `collection = "shared"`) {
+		t.Fatalf("generated HCL did not keep a literal name for a set without a collection:\n%s", hcl)
+	}
+	if len(importCommands) != 2 || importCommands[0].ImportID != "products-v2/shoe terms" {
+		t.Fatalf("import commands = %+v, want products-v2/shoe terms first", importCommands)
+	}
+}
+
 func TestGenerateCurationSetsV30EmitsImportableOverrideResources(t *testing.T) {
 	g, cleanup := newGeneratorForTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet || r.URL.Path != "/curation_sets" {
@@ -191,6 +226,31 @@ func TestGenerateCurationSetsV30EmitsImportableOverrideResources(t *testing.T) {
 	}
 }
 
+func TestGenerateCurationSetsV30ReferencesCollectionResource(t *testing.T) {
+	g, cleanup := newGeneratorForTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name":"products-v2","items":[{"id":"featured","rule":{"query":"sale","match":"exact"}}]}]`))
+	})
+	defer cleanup()
+
+	g.serverVersion = version.MustParse("30.0")
+	g.featureChecker = version.NewFeatureChecker(g.serverVersion)
+
+	f := hclwrite.NewEmptyFile()
+	collectionResourceMap := map[string]string{"products-v2": "products_v2"}
+	var importCommands []ImportCommand
+
+	if err := g.generateOverrides(context.Background(), f, make(map[string]bool), collectionResourceMap, &importCommands); err != nil {
+		t.Fatalf("generateOverrides() returned error: %v", err)
+	}
+
+	hcl := string(f.Bytes())
+	collectionRef := tfnames.FullTypeName(tfnames.ResourceCollection) + ".products_v2.name"
+	if !strings.Contains(hcl, collectionRef) {
+		t.Fatalf("generated HCL did not reference the collection resource with %q:\n%s", collectionRef, hcl)
+	}
+}
+
 func TestDocumentExportURLEscapesCollectionName(t *testing.T) {
 	got := documentExportURL("http", "127.0.0.1", 8108, "docs / prod")
 	want := "http://127.0.0.1:8108/collections/docs%20%2F%20prod/documents/export"
@@ -365,3 +425,42 @@ func TestFileSetGetIdempotent(t *testing.T) {
 		t.Error("get() should return the same file for the same name")
 	}
 }
+
+func TestRenderGeneratesOnlySelectedResourceTypes(t *testing.T) {
+	g, cleanup := newGeneratorForTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/collections":
+			_, _ = w.Write([]byte(`[{"name":"products","fields":[{"name":"title","type":"string"}]}]`))
+		case "/collections/products/synonyms":
+			_, _ = w.Write([]byte(`{"synonyms":[{"id":"shoes","synonyms":["shoe","sneaker"]}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer cleanup()
+
+	g.serverVersion = version.MustParse("29.0")
+	g.featureChecker = version.NewFeatureChecker(g.serverVersion)
+	g.config.Include = []string{tfnames.ResourceCollection, tfnames.ResourceSynonym}
+	g.config.Exclude = []string{tfnames.ResourceCollection}
+
+	fs, importCommands, err := g.render(context.Background())
+	if err != nil {
+		t.Fatalf("render() returned error: %v", err)
+	}
+
+	if len(importCommands) != 1 || importCommands[0].ResourceType != tfnames.FullTypeName(tfnames.ResourceSynonym) {
+		t.Fatalf("import commands = %+v, want only the synonym", importCommands)
+	}
+	// Without a generated collection resource to refer to, the synonym
+	// names its collection literally.
+	hcl := string(fs.get("synonyms.tf").Bytes())
+	if !strings.Contains(hcl, `collection = "products"`) {
+		t.Errorf("synonym did not name its collection literally:\n%s", hcl)
+	}
+	if collections := fs.get("collections.tf").Bytes(); len(collections) != 0 {
+		t.Errorf("excluded collections were generated:\n%s", collections)
+	}
+}
@@ -40,7 +40,7 @@ func generateProviderBlock(f *hclwrite.File, host string, port int, protocol str
 	}
 	if includeCloudAPIKey {
 		providerBlock.Body().AppendUnstructuredTokens(hclwrite.Tokens{
-			{Type: 4, Bytes: []byte("# cloud_management_api_key = \"YOUR_CLOUD_API_KEY_HERE\"\n")}, // TokenComment = 4
+			{Type: 4, Bytes: []byte("# cloud_api_key = \"YOUR_CLOUD_API_KEY_HERE\"\n")}, // TokenComment = 4
 		})
 	}
 	f.Body().AppendNewline()
@@ -77,7 +77,11 @@ func generateCollectionBlock(c *client.Collection, resourceName string) *hclwrit
 		body.SetAttributeValue("symbols_to_index", cty.ListVal(vals))
 	}
 
-	// Add fields
+	// Add fields with the types the server reports. For auto fields that is
+	// the detected type, and the fields the server added for document keys
+	// matched by an auto pattern such as ".*" are emitted too: an import
+	// keeps every server field in state, so leaving them out would plan
+	// their drop.
 	for _, field := range c.Fields {
 		fieldBlock := body.AppendNewBlock("field", nil)
 		fieldBody := fieldBlock.Body()
@@ -88,7 +92,7 @@ func generateCollectionBlock(c *client.Collection, resourceName string) *hclwrit
 		if field.Facet {
 			fieldBody.SetAttributeValue("facet", cty.BoolVal(true))
 		}
-		if field.Optional {
+		if field.Optional != nil && *field.Optional {
 			fieldBody.SetAttributeValue("optional", cty.BoolVal(true))
 		}
 		if field.Index != nil && !*field.Index {
@@ -103,10 +107,12 @@ func generateCollectionBlock(c *client.Collection, resourceName string) *hclwrit
 		if field.Locale != "" {
 			fieldBody.SetAttributeValue("locale", cty.StringVal(field.Locale))
 		}
-		if field.NumDim > 0 {
-			fieldBody.SetAttributeValue("num_dim", cty.NumberIntVal(field.NumDim))
+		if field.NumDim != nil {
+			fieldBody.SetAttributeValue("num_dim", cty.NumberIntVal(*field.NumDim))
 		}
-		if field.VecDist != "" {
+		// vec_dist is only valid on vector fields; anything else would fail
+		// the collection resource's plan-time validation.
+		if field.VecDist != "" && (field.NumDim != nil || field.Embed != nil) {
 			fieldBody.SetAttributeValue("vec_dist", cty.StringVal(field.VecDist))
 		}
 		if field.Reference != "" {
@@ -118,11 +124,14 @@ func generateCollectionBlock(c *client.Collection, resourceName string) *hclwrit
 		if field.Stem != nil && *field.Stem {
 			fieldBody.SetAttributeValue("stem", cty.BoolVal(true))
 		}
-		if field.RangeIndex != nil && *field.RangeIndex {
-			fieldBody.SetAttributeValue("range_index", cty.BoolVal(true))
+		// range_index and store are kept whenever the server reports them,
+		// so an explicit range_index = false or store = true survives a
+		// regenerate.
+		if field.RangeIndex != nil {
+			fieldBody.SetAttributeValue("range_index", cty.BoolVal(*field.RangeIndex))
 		}
-		if field.Store != nil && !*field.Store {
-			fieldBody.SetAttributeValue("store", cty.BoolVal(false))
+		if field.Store != nil {
+			fieldBody.SetAttributeValue("store", cty.BoolVal(*field.Store))
 		}
 		if len(field.TokenSeparators) > 0 {
 			sVals := make([]cty.Value, len(field.TokenSeparators))
@@ -153,6 +162,12 @@ func generateCollectionBlock(c *client.Collection, resourceName string) *hclwrit
 			if field.Embed.ModelConfig.URL != "" {
 				modelConfigVals["url"] = cty.StringVal(field.Embed.ModelConfig.URL)
 			}
+			if field.Embed.ModelConfig.IndexingPrefix != "" {
+				modelConfigVals["indexing_prefix"] = cty.StringVal(field.Embed.ModelConfig.IndexingPrefix)
+			}
+			if field.Embed.ModelConfig.QueryPrefix != "" {
+				modelConfigVals["query_prefix"] = cty.StringVal(field.Embed.ModelConfig.QueryPrefix)
+			}
 			// Intentionally omit api_key from generated HCL (sensitive)
 			embedVals["model_config"] = cty.ObjectVal(modelConfigVals)
 			fieldBody.SetAttributeValue("embed", cty.ObjectVal(embedVals))
@@ -176,6 +191,22 @@ func generateCollectionBlock(c *client.Collection, resourceName string) *hclwrit
 		body.SetAttributeValue("voice_query_model", cty.StringVal(c.VoiceQueryModel))
 	}
 
+	if len(c.SynonymSets) > 0 {
+		vals := make([]cty.Value, len(c.SynonymSets))
+		for i, v := range c.SynonymSets {
+			vals[i] = cty.StringVal(v)
+		}
+		body.SetAttributeValue("synonym_sets", cty.ListVal(vals))
+	}
+
+	if len(c.CurationSets) > 0 {
+		vals := make([]cty.Value, len(c.CurationSets))
+		for i, v := range c.CurationSets {
+			vals[i] = cty.StringVal(v)
+		}
+		body.SetAttributeValue("curation_sets", cty.ListVal(vals))
+	}
+
 	return block
 }
 
@@ -7,7 +7,10 @@ import (
 
 	"github.com/alanm/terraform-provider-typesense/internal/client"
 	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // blockToHCL converts an hclwrite.Block to its HCL string representation
@@ -37,7 +40,7 @@ func TestGenerateProviderBlockIncludesCredentialPlaceholders(t *testing.T) {
 	if !strings.Contains(hcl, `# server_api_key = "YOUR_API_KEY_HERE"`) {
 		t.Error("Provider block should include server API key placeholder when server resources are exported")
 	}
-	if !strings.Contains(hcl, `# cloud_management_api_key = "YOUR_CLOUD_API_KEY_HERE"`) {
+	if !strings.Contains(hcl, `# cloud_api_key = "YOUR_CLOUD_API_KEY_HERE"`) {
 		t.Error("Provider block should include cloud API key placeholder when cloud resources are exported")
 	}
 }
@@ -45,6 +48,7 @@ func TestGenerateProviderBlockIncludesCredentialPlaceholders(t *testing.T) {
 func TestGenerateCollectionBlock(t *testing.T) {
 	indexFalse := false
 	sortTrue := true
+	optionalTrue := true
 	collection := &client.Collection{
 		Name:                "products",
 		DefaultSortingField: "popularity",
@@ -60,7 +64,7 @@ func TestGenerateCollectionBlock(t *testing.T) {
 				Name:     "name",
 				Type:     "string",
 				Facet:    true,
-				Optional: true,
+				Optional: &optionalTrue,
 			},
 			{
 				Name:   "price",
@@ -92,19 +96,59 @@ func TestGenerateCollectionBlock(t *testing.T) {
 	}
 }
 
+func TestGenerateCollectionBlockAutoAndImageFields(t *testing.T) {
+	collection := &client.Collection{
+		Name: "products",
+		Fields: []client.CollectionField{
+			{Name: ".*", Type: "auto"},
+			{Name: "price", Type: "float"},
+			{Name: "photo", Type: "image"},
+		},
+	}
+
+	hcl := blockToHCL(generateCollectionBlock(collection, "products"))
+
+	for _, want := range []struct{ name, typ string }{{".*", "auto"}, {"price", "float"}, {"photo", "image"}} {
+		if !containsAttr(hcl, "name", `"`+want.name+`"`) || !containsAttr(hcl, "type", `"`+want.typ+`"`) {
+			t.Errorf("Block should contain field %s of type %s:\n%s", want.name, want.typ, hcl)
+		}
+	}
+}
+
+func TestGenerateCollectionBlockSetAssociations(t *testing.T) {
+	collection := &client.Collection{
+		Name:         "products",
+		Fields:       []client.CollectionField{{Name: "title", Type: "string"}},
+		SynonymSets:  []string{"shared-synonyms"},
+		CurationSets: []string{"seasonal-promotions"},
+	}
+
+	hcl := blockToHCL(generateCollectionBlock(collection, "products"))
+
+	if !containsAttr(hcl, "synonym_sets", `["shared-synonyms"]`) {
+		t.Errorf("Block should contain synonym_sets:\n%s", hcl)
+	}
+	if !containsAttr(hcl, "curation_sets", `["seasonal-promotions"]`) {
+		t.Errorf("Block should contain curation_sets:\n%s", hcl)
+	}
+}
+
 func TestGenerateCollectionBlockNestedAttributes(t *testing.T) {
+	numDim := int64(384)
 	collection := &client.Collection{
 		Name: "products",
 		Fields: []client.CollectionField{
 			{
 				Name:    "embedding",
 				Type:    "float[]",
-				NumDim:  384,
+				NumDim:  &numDim,
 				VecDist: "cosine",
 				Embed: &client.FieldEmbed{
 					From: []string{"title", "description"},
 					ModelConfig: client.FieldModelConfig{
-						ModelName: "ts/all-MiniLM-L12-v2",
+						ModelName:      "ts/all-MiniLM-L12-v2",
+						IndexingPrefix: "passage:",
+						QueryPrefix:    "query:",
 					},
 				},
 				HnswParams: &client.FieldHnswParams{
@@ -127,6 +171,9 @@ func TestGenerateCollectionBlockNestedAttributes(t *testing.T) {
 	if !containsAttr(hcl, "model_config", "{") {
 		t.Error("Block should emit model_config as an object attribute")
 	}
+	if !containsAttr(hcl, "indexing_prefix", `"passage:"`) || !containsAttr(hcl, "query_prefix", `"query:"`) {
+		t.Errorf("Block should emit the embedding prefixes:\n%s", hcl)
+	}
 	if !containsAttr(hcl, "hnsw_params", "{") {
 		t.Error("Block should emit hnsw_params as an object attribute")
 	}
@@ -604,3 +651,88 @@ func TestGenerateConversationModelBlockWithVllm(t *testing.T) {
 		t.Error("Block should contain vllm_url")
 	}
 }
+
+func TestGenerateCollectionBlockReferenceRoundTrip(t *testing.T) {
+	asyncReference := true
+	collection := &client.Collection{
+		Name: "books",
+		Fields: []client.CollectionField{
+			{Name: "title", Type: "string"},
+			{Name: "author_id", Type: "string", Reference: "authors.id", AsyncReference: &asyncReference},
+		},
+	}
+
+	src := blockToHCL(generateCollectionBlock(collection, "books"))
+	file, diags := hclsyntax.ParseConfig([]byte(src), "books.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("generated HCL does not parse: %v\n%s", diags, src)
+	}
+
+	var field *hclsyntax.Block
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks[0].Body.Blocks {
+		if block.Type != "field" {
+			continue
+		}
+		name, _ := block.Body.Attributes["name"].Expr.Value(nil)
+		if name.AsString() == "author_id" {
+			field = block
+		}
+	}
+	if field == nil {
+		t.Fatalf("no author_id field block in:\n%s", src)
+	}
+
+	reference, diags := field.Body.Attributes["reference"].Expr.Value(nil)
+	if diags.HasErrors() || reference.Type() != cty.String || reference.AsString() != "authors.id" {
+		t.Errorf("reference = %#v, want the string authors.id", reference)
+	}
+	async, diags := field.Body.Attributes["async_reference"].Expr.Value(nil)
+	if diags.HasErrors() || async.Type() != cty.Bool || !async.True() {
+		t.Errorf("async_reference = %#v, want the bool true", async)
+	}
+}
+
+func TestGenerateCollectionBlockKeepsExplicitPointerBools(t *testing.T) {
+	yes, no := true, false
+	collection := &client.Collection{
+		Name: "products",
+		Fields: []client.CollectionField{
+			{Name: "title", Type: "string", Store: &yes, RangeIndex: &no},
+			{Name: "price", Type: "float", Store: &no, RangeIndex: &yes},
+			{Name: "sku", Type: "string"},
+		},
+	}
+
+	src := blockToHCL(generateCollectionBlock(collection, "products"))
+	file, diags := hclsyntax.ParseConfig([]byte(src), "products.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("generated HCL does not parse: %v\n%s", diags, src)
+	}
+
+	want := map[string]map[string]bool{
+		"title": {"store": true, "range_index": false},
+		"price": {"store": false, "range_index": true},
+		"sku":   {},
+	}
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks[0].Body.Blocks {
+		if block.Type != "field" {
+			continue
+		}
+		name, _ := block.Body.Attributes["name"].Expr.Value(nil)
+		for _, attrName := range []string{"store", "range_index"} {
+			wantValue, wantSet := want[name.AsString()][attrName]
+			attr, set := block.Body.Attributes[attrName]
+			if set != wantSet {
+				t.Errorf("field %s: %s emitted = %v, want %v", name.AsString(), attrName, set, wantSet)
+				continue
+			}
+			if !set {
+				continue
+			}
+			value, _ := attr.Expr.Value(nil)
+			if value.True() != wantValue {
+				t.Errorf("field %s: %s = %#v, want %v", name.AsString(), attrName, value, wantValue)
+			}
+		}
+	}
+}
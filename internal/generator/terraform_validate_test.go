@@ -118,6 +118,7 @@ func TestGeneratedHCLValidatesWithTerraform(t *testing.T) {
 	root := repoRootForTerraformValidate(t)
 	providerDir := buildProviderBinaryForTerraformValidate(t, root)
 
+	numDim := int64(384)
 	baseCollection := &client.Collection{
 		Name: "products",
 		Fields: []client.CollectionField{
@@ -128,7 +129,7 @@ func TestGeneratedHCLValidatesWithTerraform(t *testing.T) {
 			{
 				Name:    "embedding",
 				Type:    "float[]",
-				NumDim:  384,
+				NumDim:  &numDim,
 				VecDist: "cosine",
 				Embed: &client.FieldEmbed{
 					From: []string{"title", "description"},
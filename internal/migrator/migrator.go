@@ -344,3 +344,33 @@ func (m *Migrator) importStopwordsSets(ctx context.Context, stopwordsFile string
 	fmt.Printf("Imported %d stopwords sets\n", len(stopwordsSets))
 	return nil
 }
+
+// UpgradeCurations converts the per-collection synonyms and overrides of each
+// collection on the target (Typesense v29 layout) into v30 synonym and
+// curation sets named after the collection. When collections is empty, every
+// collection on the target is converted.
+func (m *Migrator) UpgradeCurations(ctx context.Context, collections []string) error {
+	if len(collections) == 0 {
+		existing, err := m.targetClient.ListCollections(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list collections: %w", err)
+		}
+		for _, c := range existing {
+			collections = append(collections, c.Name)
+		}
+	}
+
+	if len(collections) == 0 {
+		fmt.Println("No collections found to upgrade")
+		return nil
+	}
+
+	for _, name := range collections {
+		fmt.Printf("Upgrading curations for collection: %s\n", name)
+		if err := m.targetClient.MigrateCollectionCurations(ctx, name); err != nil {
+			return fmt.Errorf("failed to upgrade curations for %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -3,8 +3,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/alanm/terraform-provider-typesense/internal/client"
 	"github.com/alanm/terraform-provider-typesense/internal/datasources"
@@ -14,6 +16,7 @@ import (
 	"github.com/alanm/terraform-provider-typesense/internal/version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,6 +37,8 @@ type TypesenseProvider struct {
 // TypesenseProviderModel describes the provider data model.
 type TypesenseProviderModel struct {
 	// Cloud Management API configuration
+	CloudAPIKey           types.String `tfsdk:"cloud_api_key"`
+	CloudAPIURL           types.String `tfsdk:"cloud_api_url"`
 	CloudManagementAPIKey types.String `tfsdk:"cloud_management_api_key"`
 
 	// Server API configuration
@@ -41,6 +46,35 @@ type TypesenseProviderModel struct {
 	ServerAPIKey   types.String `tfsdk:"server_api_key"`
 	ServerPort     types.Int64  `tfsdk:"server_port"`
 	ServerProtocol types.String `tfsdk:"server_protocol"`
+	BasePath       types.String `tfsdk:"base_path"`
+	ExtraHeaders   types.Map    `tfsdk:"extra_headers"`
+
+	// TLS configuration
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ForceHTTP2         types.Bool   `tfsdk:"force_http2"`
+
+	// Cloud cluster polling
+	ClusterPollIntervalSeconds    types.Int64 `tfsdk:"cluster_poll_interval_seconds"`
+	ClusterPollMaxIntervalSeconds types.Int64 `tfsdk:"cluster_poll_max_interval_seconds"`
+
+	// Circuit breaker configuration
+	CircuitBreakerThreshold       types.Int64 `tfsdk:"circuit_breaker_threshold"`
+	CircuitBreakerCooldownSeconds types.Int64 `tfsdk:"circuit_breaker_cooldown_seconds"`
+
+	// Synonym sets
+	SynonymSetWriteRetries types.Int64 `tfsdk:"synonym_set_write_retries"`
+
+	// Naming
+	CollectionNamePrefix types.String `tfsdk:"collection_name_prefix"`
+
+	// Version detection
+	ServerVersion          types.String `tfsdk:"server_version"`
+	IgnoreVersionChecks    types.Bool   `tfsdk:"ignore_version_checks"`
+	VersionCacheTTLSeconds types.Int64  `tfsdk:"version_cache_ttl_seconds"`
+
+	// Diagnostics
+	DebugHTTP types.Bool `tfsdk:"debug_http"`
 }
 
 // ProviderData is an alias for the shared type
@@ -55,11 +89,29 @@ func (p *TypesenseProvider) Schema(ctx context.Context, req provider.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "The Typesense provider allows you to manage Typesense Cloud clusters and server resources like collections, synonyms, overrides, stopwords, and API keys.",
 		Attributes: map[string]schema.Attribute{
-			"cloud_management_api_key": schema.StringAttribute{
-				Description: "API key for Typesense Cloud Management API. Can also be set via TYPESENSE_CLOUD_MANAGEMENT_API_KEY environment variable.",
+			"cloud_api_key": schema.StringAttribute{
+				Description: "API key for the Typesense Cloud Management API, used only by typesense_cluster, typesense_cluster_config_change and the typesense_cluster data source. Not needed for self-hosted servers; it is unrelated to server_api_key. Can also be set via TYPESENSE_CLOUD_API_KEY environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"cloud_api_url": schema.StringAttribute{
+				Description: "Base URL of the Typesense Cloud Management API. Defaults to " + client.CloudAPIBaseURL + ". Can also be set via TYPESENSE_CLOUD_API_URL environment variable.",
+				Optional:    true,
+			},
+			"cloud_management_api_key": schema.StringAttribute{
+				Description:        "Deprecated alias of cloud_api_key. Can also be set via TYPESENSE_CLOUD_MANAGEMENT_API_KEY environment variable.",
+				DeprecationMessage: "Use cloud_api_key instead.",
+				Optional:           true,
+				Sensitive:          true,
+			},
+			"cluster_poll_interval_seconds": schema.Int64Attribute{
+				Description: "Seconds to wait before the first status check while a typesense_cluster is being created or changed. The wait doubles after every check, up to cluster_poll_max_interval_seconds. Defaults to 5. Can also be set via TYPESENSE_CLUSTER_POLL_INTERVAL_SECONDS environment variable.",
+				Optional:    true,
+			},
+			"cluster_poll_max_interval_seconds": schema.Int64Attribute{
+				Description: "Longest wait between status checks of a typesense_cluster that is not ready yet. Defaults to 30. Can also be set via TYPESENSE_CLUSTER_POLL_MAX_INTERVAL_SECONDS environment variable.",
+				Optional:    true,
+			},
 			"server_host": schema.StringAttribute{
 				Description: "Hostname of the Typesense server (e.g., 'xxx.a1.typesense.net' or 'localhost'). Can also be set via TYPESENSE_HOST environment variable.",
 				Optional:    true,
@@ -77,6 +129,60 @@ func (p *TypesenseProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Description: "Protocol for connecting to Typesense server ('http' or 'https'). Defaults to 'https'. Can also be set via TYPESENSE_PROTOCOL environment variable.",
 				Optional:    true,
 			},
+			"base_path": schema.StringAttribute{
+				Description: "Path prefix added to every Typesense server request, for servers exposed under a path behind a reverse proxy, e.g. \"/search\" for https://host/search/collections. Defaults to none. Can also be set via TYPESENSE_BASE_PATH environment variable.",
+				Optional:    true,
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Headers added to every Typesense server request, e.g. an Authorization header for an authenticating proxy in front of the server. They cannot set X-TYPESENSE-API-KEY; use server_api_key for that.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM file with CA certificates to trust when connecting to the Typesense server over HTTPS, in addition to the system roots. Use this for servers whose certificate is issued by an internal CA. Can also be set via TYPESENSE_CA_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the Typesense server's TLS certificate. Only for development; it makes the connection vulnerable to interception. Can also be set via TYPESENSE_INSECURE_SKIP_VERIFY environment variable.",
+				Optional:    true,
+			},
+			"force_http2": schema.BoolAttribute{
+				Description: "Talk to the Typesense server over HTTP/2 only, with prior knowledge (h2c) when server_protocol is http, so concurrent requests such as parallel document imports share one connection. HTTP/2 is already negotiated on HTTPS when the server supports it; set this when a proxy or plain-HTTP server supports HTTP/2 but the provider does not use it. Requests fail against servers without HTTP/2. Can also be set via TYPESENSE_FORCE_HTTP2 environment variable.",
+				Optional:    true,
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				Description: "Number of consecutive connection failures or 502/503/504 responses after which requests to a Typesense host fail fast instead of being sent. Defaults to 0, which disables the circuit breaker. Can also be set via TYPESENSE_CIRCUIT_BREAKER_THRESHOLD environment variable.",
+				Optional:    true,
+			},
+			"circuit_breaker_cooldown_seconds": schema.Int64Attribute{
+				Description: "Seconds a host stays skipped once the circuit breaker opens. After the cooldown the host is probed via /health and restored if healthy. Defaults to 30. Can also be set via TYPESENSE_CIRCUIT_BREAKER_COOLDOWN_SECONDS environment variable.",
+				Optional:    true,
+			},
+			"synonym_set_write_retries": schema.Int64Attribute{
+				Description: "How often a synonym set write that lost a race with another writer is retried: a whole-set update rejected with a conflict is re-read, re-merged and sent again, and a synonym that disappeared from its set after being written is written again. Defaults to 2. Can also be set via TYPESENSE_SYNONYM_SET_WRITE_RETRIES environment variable.",
+				Optional:    true,
+			},
+			"collection_name_prefix": schema.StringAttribute{
+				Description: "Prefix added to collection names, alias names and the collection of synonyms and overrides when talking to the Typesense server, e.g. \"staging_\". Configuration keeps the unprefixed names; the id of each resource is the name on the server. Can also be set via TYPESENSE_COLLECTION_NAME_PREFIX environment variable.",
+				Optional:    true,
+			},
+			"server_version": schema.StringAttribute{
+				Description: "Version of the Typesense server, e.g. \"29.0\". When set, the provider uses it to choose between version-specific APIs instead of asking the server's /debug endpoint, which some hosted plans block. Without it, a server whose version cannot be detected is treated as v30. Can also be set via TYPESENSE_SERVER_VERSION environment variable.",
+				Optional:    true,
+			},
+			"ignore_version_checks": schema.BoolAttribute{
+				Description: "Do not reject resources that need a newer Typesense version than the server reports, and let the server decide instead. For patched or pre-release builds whose version number understates their features. The version is still used to choose between version-specific APIs. Defaults to false. Can also be set via TYPESENSE_IGNORE_VERSION_CHECKS environment variable.",
+				Optional:    true,
+			},
+			"version_cache_ttl_seconds": schema.Int64Attribute{
+				Description: "Seconds after which the server version used to choose between version-specific APIs is detected again, so a long-running process notices an in-place server upgrade. Defaults to 0, which detects it once. Not used when server_version is set. Can also be set via TYPESENSE_VERSION_CACHE_TTL_SECONDS environment variable.",
+				Optional:    true,
+			},
+			"debug_http": schema.BoolAttribute{
+				Description: "Log Typesense server request and response bodies at debug level (visible with TF_LOG=debug). Method, path, status and duration are always logged. Bodies can contain sensitive data such as generated API keys. Can also be set via TYPESENSE_DEBUG_HTTP environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -91,30 +197,138 @@ func (p *TypesenseProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	// Get values from config or environment variables
-	cloudAPIKey := getStringValue(config.CloudManagementAPIKey, "TYPESENSE_CLOUD_MANAGEMENT_API_KEY")
+	if !config.CloudAPIKey.IsNull() && !config.CloudManagementAPIKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cloud_management_api_key"),
+			"Conflicting Cloud API Keys",
+			"cloud_management_api_key is a deprecated alias of cloud_api_key; set only cloud_api_key.",
+		)
+		return
+	}
+	cloudAPIKey := getStringValue(config.CloudAPIKey, "TYPESENSE_CLOUD_API_KEY")
+	if cloudAPIKey == "" {
+		cloudAPIKey = getStringValue(config.CloudManagementAPIKey, "TYPESENSE_CLOUD_MANAGEMENT_API_KEY")
+	}
 	serverHost := getStringValue(config.ServerHost, "TYPESENSE_HOST")
 	serverAPIKey := getStringValue(config.ServerAPIKey, "TYPESENSE_API_KEY")
 	serverPort := getInt64Value(config.ServerPort, "TYPESENSE_PORT", 443)
 	serverProtocol := getStringValueWithDefault(config.ServerProtocol, "TYPESENSE_PROTOCOL", "https")
 
-	providerData := &providertypes.ProviderData{}
+	userAgent := client.UserAgent(p.version, req.TerraformVersion)
+
+	providerData := &providertypes.ProviderData{
+		CollectionNamePrefix: getStringValue(config.CollectionNamePrefix, "TYPESENSE_COLLECTION_NAME_PREFIX"),
+	}
 
 	// Configure Cloud client if API key is provided
 	if cloudAPIKey != "" {
 		providerData.CloudClient = client.NewCloudClient(cloudAPIKey)
+		providerData.CloudClient.SetUserAgent(userAgent)
+		providerData.CloudClient.SetBaseURL(getStringValueWithDefault(config.CloudAPIURL, "TYPESENSE_CLOUD_API_URL", client.CloudAPIBaseURL))
+
+		pollInterval := getInt64Value(config.ClusterPollIntervalSeconds, "TYPESENSE_CLUSTER_POLL_INTERVAL_SECONDS", 5)
+		pollMaxInterval := getInt64Value(config.ClusterPollMaxIntervalSeconds, "TYPESENSE_CLUSTER_POLL_MAX_INTERVAL_SECONDS", 30)
+		if pollInterval < 1 || pollMaxInterval < pollInterval {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cluster_poll_interval_seconds"),
+				"Invalid Cluster Poll Interval",
+				"cluster_poll_interval_seconds must be at least 1, and cluster_poll_max_interval_seconds must not be smaller than it.",
+			)
+			return
+		}
+		providerData.CloudClient.SetClusterPollInterval(time.Duration(pollInterval)*time.Second, time.Duration(pollMaxInterval)*time.Second)
 	}
 
 	// Configure Server client if host and API key are provided
 	if serverHost != "" && serverAPIKey != "" {
 		providerData.ServerClient = client.NewServerClient(serverHost, serverAPIKey, int(serverPort), serverProtocol)
+		providerData.ServerClient.SetBasePath(getStringValue(config.BasePath, "TYPESENSE_BASE_PATH"))
+		providerData.ServerClient.SetUserAgent(userAgent)
+		var extraHeaders map[string]string
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := providerData.ServerClient.SetExtraHeaders(extraHeaders); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("extra_headers"), "Invalid Extra Headers", err.Error())
+			return
+		}
+		providerData.ServerClient.SetDebugHTTP(getBoolValue(config.DebugHTTP, "TYPESENSE_DEBUG_HTTP"))
+
+		caCertFile := getStringValue(config.CACertFile, "TYPESENSE_CA_CERT_FILE")
+		insecureSkipVerify := getBoolValue(config.InsecureSkipVerify, "TYPESENSE_INSECURE_SKIP_VERIFY")
+		if err := providerData.ServerClient.ConfigureTLS(caCertFile, insecureSkipVerify); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Invalid TLS Configuration",
+				fmt.Sprintf("Unable to load the CA certificate for the Typesense server: %s", err),
+			)
+			return
+		}
+
+		providerData.ServerClient.ConfigureHTTP2(getBoolValue(config.ForceHTTP2, "TYPESENSE_FORCE_HTTP2"))
+
+		breakerThreshold := getInt64Value(config.CircuitBreakerThreshold, "TYPESENSE_CIRCUIT_BREAKER_THRESHOLD", 0)
+		breakerCooldown := getInt64Value(config.CircuitBreakerCooldownSeconds, "TYPESENSE_CIRCUIT_BREAKER_COOLDOWN_SECONDS", 30)
+		if breakerThreshold < 0 || breakerCooldown < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("circuit_breaker_threshold"),
+				"Invalid Circuit Breaker Configuration",
+				"circuit_breaker_threshold and circuit_breaker_cooldown_seconds must not be negative.",
+			)
+			return
+		}
+		providerData.ServerClient.ConfigureCircuitBreaker(int(breakerThreshold), time.Duration(breakerCooldown)*time.Second)
+
+		synonymSetWriteRetries := getInt64Value(config.SynonymSetWriteRetries, "TYPESENSE_SYNONYM_SET_WRITE_RETRIES", 2)
+		if synonymSetWriteRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("synonym_set_write_retries"),
+				"Invalid Synonym Set Write Retries",
+				"synonym_set_write_retries must not be negative.",
+			)
+			return
+		}
+		providerData.ServerClient.SetSynonymSetWriteRetries(int(synonymSetWriteRetries))
 
-		// Detect server version for feature-aware API selection
-		serverVersion, featureChecker, versionDiag := detectServerVersion(ctx, providerData.ServerClient)
-		if versionDiag != nil {
-			resp.Diagnostics.Append(versionDiag)
+		versionCacheTTL := getInt64Value(config.VersionCacheTTLSeconds, "TYPESENSE_VERSION_CACHE_TTL_SECONDS", 0)
+		if versionCacheTTL < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("version_cache_ttl_seconds"),
+				"Invalid Version Cache TTL",
+				"version_cache_ttl_seconds must not be negative.",
+			)
+			return
+		}
+		providerData.ServerClient.SetVersionCacheTTL(time.Duration(versionCacheTTL) * time.Second)
+
+		// Use the configured server version, or detect it, for feature-aware
+		// API selection
+		if pinned := getStringValue(config.ServerVersion, "TYPESENSE_SERVER_VERSION"); pinned != "" {
+			serverVersion, err := version.Parse(pinned)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("server_version"),
+					"Invalid Server Version",
+					fmt.Sprintf("Unable to parse server_version %q: %s", pinned, err),
+				)
+				return
+			}
+			providerData.ServerClient.PinServerVersion(serverVersion.String(), serverVersion.Major)
+			providerData.ServerVersion = serverVersion
+			providerData.FeatureChecker = version.NewFeatureChecker(serverVersion)
+		} else {
+			serverVersion, featureChecker, versionDiag := detectServerVersion(ctx, providerData.ServerClient)
+			if versionDiag != nil {
+				resp.Diagnostics.Append(versionDiag)
+			}
+			providerData.ServerVersion = serverVersion
+			providerData.FeatureChecker = featureChecker
+		}
+
+		if getBoolValue(config.IgnoreVersionChecks, "TYPESENSE_IGNORE_VERSION_CHECKS") {
+			providerData.FeatureChecker = version.NewUngatedFeatureChecker(providerData.FeatureChecker)
 		}
-		providerData.ServerVersion = serverVersion
-		providerData.FeatureChecker = featureChecker
 	} else {
 		// No server client, use fallback feature checker
 		providerData.FeatureChecker = version.NewFallbackFeatureChecker()
@@ -131,6 +345,7 @@ func (p *TypesenseProvider) Resources(ctx context.Context) []func() resource.Res
 		resources.NewCollectionResource,
 		resources.NewCollectionAliasResource,
 		resources.NewSynonymResource,
+		resources.NewSynonymSetResource,
 		resources.NewOverrideResource,
 		resources.NewStopwordsSetResource,
 		resources.NewPresetResource,
@@ -139,6 +354,7 @@ func (p *TypesenseProvider) Resources(ctx context.Context) []func() resource.Res
 		resources.NewNLSearchModelResource,
 		resources.NewConversationModelResource,
 		resources.NewStemmingDictionaryResource,
+		resources.NewConfigResource,
 	}
 }
 
@@ -147,6 +363,25 @@ func (p *TypesenseProvider) DataSources(ctx context.Context) []func() datasource
 		datasources.NewCollectionsDataSource,
 		datasources.NewAPIKeysDataSource,
 		datasources.NewServerInfoDataSource,
+		datasources.NewNLSearchModelsDataSource,
+		datasources.NewStopwordsDataSource,
+		datasources.NewStatsDataSource,
+		datasources.NewAnalyticsRulesDataSource,
+		datasources.NewCollectionDataSource,
+		datasources.NewMultiSearchDataSource,
+		datasources.NewConversationModelsDataSource,
+		datasources.NewClusterDataSource,
+		datasources.NewCollectionDocumentsDataSource,
+		datasources.NewCollectionSchemaValidationDataSource,
+		datasources.NewSynonymDataSource,
+		datasources.NewCollectionReadinessDataSource,
+		datasources.NewStopwordsSetsDataSource,
+		datasources.NewFeaturesDataSource,
+		datasources.NewOverrideDataSource,
+		datasources.NewHealthDataSource,
+		datasources.NewMetricsDataSource,
+		datasources.NewDocumentDataSource,
+		datasources.NewClusterNodesDataSource,
 	}
 }
 
@@ -178,6 +413,14 @@ func getStringValueWithDefault(tfValue types.String, envVar, defaultValue string
 	return defaultValue
 }
 
+func getBoolValue(tfValue types.Bool, envVar string) bool {
+	if !tfValue.IsNull() && !tfValue.IsUnknown() {
+		return tfValue.ValueBool()
+	}
+	val, _ := strconv.ParseBool(os.Getenv(envVar))
+	return val
+}
+
 func getInt64Value(tfValue types.Int64, envVar string, defaultValue int64) int64 {
 	if !tfValue.IsNull() && !tfValue.IsUnknown() {
 		return tfValue.ValueInt64()
@@ -191,10 +434,12 @@ func getInt64Value(tfValue types.Int64, envVar string, defaultValue int64) int64
 }
 
 // detectServerVersion queries the server for version information and creates
-// an appropriate FeatureChecker. On failure, it returns a warning diagnostic
-// and a FallbackFeatureChecker that allows runtime detection via 404 handling.
+// an appropriate FeatureChecker. The client caches the result, so resources
+// that check the version later do not query the server again. On failure, it
+// returns a warning diagnostic and a FallbackFeatureChecker that allows
+// runtime detection via 404 handling.
 func detectServerVersion(ctx context.Context, serverClient *client.ServerClient) (*version.Version, version.FeatureChecker, diag.Diagnostic) {
-	info, err := serverClient.GetServerInfo(ctx)
+	info, err := serverClient.DetectServerVersion(ctx)
 	if err != nil {
 		// Version detection failed - use fallback checker
 		// This is a warning, not an error, because resources can still
@@ -2,16 +2,25 @@ package provider
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"sort"
+	"strconv"
+	"sync/atomic"
 	"testing"
 
 	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	frameworkprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -110,3 +119,177 @@ func TestRegisteredResourceAndDataSourceTypeNamesMatchSharedRegistry(t *testing.
 		}
 	}
 }
+
+func TestConfigureServerVersionSkipsDetection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse server URL: %v", err)
+	}
+	port, _ := strconv.ParseInt(serverURL.Port(), 10, 64)
+
+	ctx := context.Background()
+	p := New("test")()
+	var schemaResp frameworkprovider.SchemaResponse
+	p.Schema(ctx, frameworkprovider.SchemaRequest{}, &schemaResp)
+
+	// tfsdk.Config cannot be written to, so the values are set on a state.
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("server_host"), serverURL.Hostname())
+	diags.Append(state.SetAttribute(ctx, path.Root("server_port"), port)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("server_protocol"), "http")...)
+	diags.Append(state.SetAttribute(ctx, path.Root("server_api_key"), "test-api-key")...)
+	diags.Append(state.SetAttribute(ctx, path.Root("server_version"), "29.0")...)
+	if diags.HasError() {
+		t.Fatalf("failed to build config: %v", diags)
+	}
+
+	var resp frameworkprovider.ConfigureResponse
+	p.Configure(ctx, frameworkprovider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("Configure returned diagnostics: %v", resp.Diagnostics)
+	}
+
+	providerData := resp.ResourceData.(*ProviderData)
+	if providerData.FeatureChecker.SupportsFeature(version.FeatureSynonymSets) {
+		t.Errorf("server_version = 29.0: synonym sets supported, want the v29 per-collection synonyms API")
+	}
+	if got := providerData.ServerClient.GetMajorVersion(ctx); got != 29 {
+		t.Errorf("GetMajorVersion() = %d, want 29", got)
+	}
+}
+
+func TestConfigureDetectsServerVersionOnce(t *testing.T) {
+	var debugCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/debug" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		debugCalls.Add(1)
+		_, _ = w.Write([]byte(`{"state": 1, "version": "29.0"}`))
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse server URL: %v", err)
+	}
+	port, _ := strconv.ParseInt(serverURL.Port(), 10, 64)
+
+	ctx := context.Background()
+	p := New("test")()
+	var schemaResp frameworkprovider.SchemaResponse
+	p.Schema(ctx, frameworkprovider.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("server_host"), serverURL.Hostname())
+	diags.Append(state.SetAttribute(ctx, path.Root("server_port"), port)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("server_protocol"), "http")...)
+	diags.Append(state.SetAttribute(ctx, path.Root("server_api_key"), "test-api-key")...)
+	if diags.HasError() {
+		t.Fatalf("failed to build config: %v", diags)
+	}
+
+	var resp frameworkprovider.ConfigureResponse
+	p.Configure(ctx, frameworkprovider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure returned errors: %v", resp.Diagnostics)
+	}
+
+	// Every resource and data source gets the same provider data, and
+	// version-gated client calls use the version detected above.
+	for _, factory := range p.Resources(ctx) {
+		if r, ok := factory().(resource.ResourceWithConfigure); ok {
+			var configureResp resource.ConfigureResponse
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: resp.ResourceData}, &configureResp)
+		}
+	}
+	for _, factory := range p.DataSources(ctx) {
+		if d, ok := factory().(datasource.DataSourceWithConfigure); ok {
+			var configureResp datasource.ConfigureResponse
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: resp.DataSourceData}, &configureResp)
+		}
+	}
+	serverClient := resp.ResourceData.(*ProviderData).ServerClient
+	for i := 0; i < 10; i++ {
+		if got := serverClient.GetMajorVersion(ctx); got != 29 {
+			t.Fatalf("GetMajorVersion() = %d, want 29", got)
+		}
+	}
+
+	if got := debugCalls.Load(); got != 1 {
+		t.Errorf("/debug called %d times, want 1", got)
+	}
+}
+
+func TestConfigureClusterPollInterval(t *testing.T) {
+	tests := []struct {
+		name        string
+		interval    int64
+		maxInterval int64
+		wantError   bool
+	}{
+		{name: "valid", interval: 2, maxInterval: 10},
+		{name: "zero interval", interval: 0, maxInterval: 10, wantError: true},
+		{name: "max below interval", interval: 10, maxInterval: 5, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			p := New("test")()
+			var schemaResp frameworkprovider.SchemaResponse
+			p.Schema(ctx, frameworkprovider.SchemaRequest{}, &schemaResp)
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := state.SetAttribute(ctx, path.Root("cloud_api_key"), "test-cloud-key")
+			diags.Append(state.SetAttribute(ctx, path.Root("cluster_poll_interval_seconds"), tt.interval)...)
+			diags.Append(state.SetAttribute(ctx, path.Root("cluster_poll_max_interval_seconds"), tt.maxInterval)...)
+			if diags.HasError() {
+				t.Fatalf("failed to build config: %v", diags)
+			}
+
+			var resp frameworkprovider.ConfigureResponse
+			p.Configure(ctx, frameworkprovider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("errors = %v, want error %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestConfigureRejectsNegativeVersionCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+	var schemaResp frameworkprovider.SchemaResponse
+	p.Schema(ctx, frameworkprovider.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("server_host"), "localhost")
+	diags.Append(state.SetAttribute(ctx, path.Root("server_api_key"), "test-api-key")...)
+	diags.Append(state.SetAttribute(ctx, path.Root("version_cache_ttl_seconds"), int64(-1))...)
+	if diags.HasError() {
+		t.Fatalf("failed to build config: %v", diags)
+	}
+
+	var resp frameworkprovider.ConfigureResponse
+	p.Configure(ctx, frameworkprovider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("Configure accepted a negative version_cache_ttl_seconds")
+	}
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"os"
+	"strconv"
 	"testing"
 
+	"github.com/alanm/terraform-provider-typesense/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -26,3 +28,19 @@ func TestAccPreCheck(t *testing.T) {
 		t.Fatal("TYPESENSE_API_KEY must be set for acceptance tests")
 	}
 }
+
+// TestAccServerClient returns a client for the acceptance test server, for
+// setting up or checking objects outside of Terraform.
+func TestAccServerClient() *client.ServerClient {
+	port := 8108
+	if v, err := strconv.Atoi(os.Getenv("TYPESENSE_PORT")); err == nil {
+		port = v
+	}
+	protocol := os.Getenv("TYPESENSE_PROTOCOL")
+	if protocol == "" {
+		protocol = "http"
+	}
+	c := client.NewServerClient(os.Getenv("TYPESENSE_HOST"), os.Getenv("TYPESENSE_API_KEY"), port, protocol)
+	c.SetBasePath(os.Getenv("TYPESENSE_BASE_PATH"))
+	return c
+}
@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &APIKeyResource{}
 var _ resource.ResourceWithImportState = &APIKeyResource{}
+var _ resource.ResourceWithModifyPlan = &APIKeyResource{}
 
 // NewAPIKeyResource creates a new API key resource
 func NewAPIKeyResource() resource.Resource {
@@ -58,12 +59,14 @@ func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"value": schema.StringAttribute{
-				Description: "The API key value. Set this to use a specific key value (e.g., for consistent keys across environments). If omitted, Typesense generates one automatically. Only the full value is available at creation time; subsequent reads return only a 4-character prefix.",
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
+				Description: "The API key value. Set this to use a specific key value (e.g., for consistent keys across environments). If omitted, Typesense generates one automatically. " +
+					"Only the full value is available at creation time, so it is captured into state then and kept as is afterwards; subsequent reads return only a 4-character prefix. " +
+					"An imported key has no value in state.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					apiKeyValueFromState{},
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -75,8 +78,11 @@ func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"description": schema.StringAttribute{
-				Description: "A description for the API key.",
+				Description: "A description for the API key. Typesense cannot update keys, so changing it deletes the key and creates a new one; unless value is set, the new key has a different value and the old one stops working.",
 				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"actions": schema.ListAttribute{
 				Description: "List of actions this key can perform (e.g., 'documents:search', 'documents:get', 'collections:create', '*').",
@@ -103,6 +109,31 @@ func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// apiKeyValueFromState plans the generated key value as the one in state.
+// Unlike UseStateForUnknown it also keeps a null value, which an imported key
+// has since Typesense never returns the full value again; planning it unknown
+// would make RequiresReplace replace the imported key.
+type apiKeyValueFromState struct{}
+
+func (m apiKeyValueFromState) Description(ctx context.Context) string {
+	return "Keeps the key value captured at creation, or null for an imported key, unless a value is configured."
+}
+
+func (m apiKeyValueFromState) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m apiKeyValueFromState) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Creates and destroys have no state to keep.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
 func (r *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -129,6 +160,40 @@ func (r *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = providerData.ServerClient
 }
 
+// ModifyPlan warns when a description change replaces the key with a newly
+// generated value. RequiresReplace alone only marks the plan, which is easy to
+// miss for what looks like a cosmetic edit.
+func (r *APIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Creates and destroys have nothing to replace.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state APIKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Description.IsUnknown() || plan.Description.Equal(state.Description) {
+		return
+	}
+
+	var config APIKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || !config.Value.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("description"),
+		"API Key Will Be Replaced",
+		fmt.Sprintf("Typesense cannot update API keys, so changing the description deletes key %s and creates a new one with a different value. "+
+			"Clients using the old value stop working. Set value to keep the same key value across the replacement.", state.ID.ValueString()),
+	)
+}
+
 func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data APIKeyResourceModel
 
@@ -232,7 +297,8 @@ func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		data.ValuePrefix = types.StringValue(apiKey.Value)
 	}
 
-	// Note: data.Value is preserved from state (UseStateForUnknown plan modifier)
+	// Note: data.Value is preserved from state. The full value only exists in
+	// the create response (see apiKeyValueFromState)
 	// Note: data.AutoDelete is preserved from state (not returned by GET API)
 
 	// Update actions
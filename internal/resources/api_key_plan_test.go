@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAPIKeyModifyPlanWarnsOnDescriptionChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		value       types.String
+		wantWarning bool
+	}{
+		{name: "unchanged", description: "Search key"},
+		{name: "changed", description: "Frontend search key", wantWarning: true},
+		{name: "changed with configured value", description: "Frontend search key", value: types.StringValue("xyz-full-key")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &APIKeyResource{}
+			state := apiKeyState(t, r, types.StringValue("xyz-full-key"))
+			if diags := state.SetAttribute(ctx, path.Root("description"), "Search key"); diags.HasError() {
+				t.Fatalf("failed to build state: %v", diags)
+			}
+
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+			if diags := plan.SetAttribute(ctx, path.Root("description"), tt.description); diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+			// The config keeps the value null unless the test configures one.
+			configState := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+			if diags := configState.SetAttribute(ctx, path.Root("value"), tt.value); diags.HasError() {
+				t.Fatalf("failed to build config: %v", diags)
+			}
+			config := tfsdk.Config{Schema: plan.Schema, Raw: configState.Raw}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan returned errors: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("warnings = %v, want warning %v", resp.Diagnostics, tt.wantWarning)
+			}
+		})
+	}
+}
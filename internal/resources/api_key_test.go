@@ -7,6 +7,7 @@ import (
 	"github.com/alanm/terraform-provider-typesense/internal/provider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccAPIKeyResource_basic(t *testing.T) {
@@ -131,6 +132,28 @@ func TestAccAPIKeyResource_autodelete(t *testing.T) {
 	})
 }
 
+func TestAccAPIKeyResource_descriptionChangeReplaces(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyResourceConfig_description("Search key"),
+				Check:  resource.TestCheckResourceAttr("typesense_api_key.test", "description", "Search key"),
+			},
+			{
+				Config: testAccAPIKeyResourceConfig_description("Frontend search key"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("typesense_api_key.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("typesense_api_key.test", "description", "Frontend search key"),
+			},
+		},
+	})
+}
+
 func testAccAPIKeyResourceConfig_basic(_ string) string {
 	return `
 resource "typesense_api_key" "test" {
@@ -173,3 +196,13 @@ resource "typesense_api_key" "test" {
 }
 `
 }
+
+func testAccAPIKeyResourceConfig_description(description string) string {
+	return fmt.Sprintf(`
+resource "typesense_api_key" "test" {
+  description = %q
+  actions     = ["documents:search"]
+  collections = ["*"]
+}
+`, description)
+}
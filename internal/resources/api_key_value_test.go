@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func apiKeyState(t *testing.T, r *APIKeyResource, value types.String) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("id"), "7")
+	diags.Append(state.SetAttribute(ctx, path.Root("value"), value)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("actions"), []string{"documents:search"})...)
+	diags.Append(state.SetAttribute(ctx, path.Root("collections"), []string{"*"})...)
+	if diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+	return state
+}
+
+func TestAPIKeyValueFromState(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  types.String
+		config types.String
+		want   types.String
+	}{
+		{name: "captured at create", state: types.StringValue("xyz-full-key"), config: types.StringNull(), want: types.StringValue("xyz-full-key")},
+		{name: "imported", state: types.StringNull(), config: types.StringNull(), want: types.StringNull()},
+		{name: "configured", state: types.StringValue("old"), config: types.StringValue("new"), want: types.StringValue("new")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &APIKeyResource{}
+			state := apiKeyState(t, r, tt.state)
+
+			planValue := types.StringUnknown()
+			if !tt.config.IsNull() {
+				planValue = tt.config
+			}
+			req := planmodifier.StringRequest{
+				Path:        path.Root("value"),
+				State:       state,
+				Plan:        tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+				StateValue:  tt.state,
+				ConfigValue: tt.config,
+				PlanValue:   planValue,
+			}
+			resp := planmodifier.StringResponse{PlanValue: planValue}
+			apiKeyValueFromState{}.PlanModifyString(ctx, req, &resp)
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("planned value = %s, want %s", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIKeyReadKeepsCreatedValue(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/keys/7" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 7, "value_prefix": "xyz-", "value": "xyz-", "actions": ["documents:search"], "collections": ["*"]}`))
+	})
+
+	ctx := context.Background()
+	r := &APIKeyResource{client: newTestServerClient(t, handler)}
+	state := apiKeyState(t, r, types.StringValue("xyz-full-key"))
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var value types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("value"), &value)...)
+	if value.ValueString() != "xyz-full-key" {
+		t.Errorf("value = %s, want the full value captured at create", value)
+	}
+}
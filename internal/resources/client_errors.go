@@ -0,0 +1,17 @@
+package resources
+
+import "github.com/alanm/terraform-provider-typesense/internal/client"
+
+// clientErrorSummary returns the diagnostic summary for a failed API call.
+// Errors from endpoints the server is too old to have get their own summary,
+// so the Typesense version they need is not mistaken for a missing object, and
+// so do creates of objects that already exist.
+func clientErrorSummary(err error) string {
+	if client.IsVersionUnsupported(err) {
+		return "Unsupported Typesense Version"
+	}
+	if isAlreadyExists(err) {
+		return "Object Already Exists"
+	}
+	return "Client Error"
+}
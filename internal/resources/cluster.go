@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -119,9 +120,12 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.List{
+					clusterRegionsValidator{},
+				},
 			},
 			"status": schema.StringAttribute{
-				Description: "Current status of the cluster.",
+				Description: "Current status of the cluster as reported by Typesense Cloud (e.g. 'provisioning', 'in_service'). Refreshed on every read.",
 				Computed:    true,
 			},
 			"load_balanced_hostname": schema.StringAttribute{
@@ -175,7 +179,7 @@ func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	if providerData.CloudClient == nil {
 		resp.Diagnostics.AddError(
 			"Cloud Management API Not Configured",
-			"The cloud_management_api_key must be configured in the provider to manage clusters.",
+			"The cloud_api_key must be configured in the provider to manage clusters.",
 		)
 		return
 	}
@@ -273,11 +277,15 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	if cluster == nil {
+	// A cluster terminated in the console can still be listed for a while
+	// with the terminated status; both cases mean it is gone.
+	if cluster == nil || cluster.Status == "terminated" {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
+	// Every attribute comes from the API, so changes made in the console,
+	// e.g. a new name or memory size, show up as drift on the next plan.
 	r.updateModelFromCluster(&data, cluster)
 
 	// Restore API keys from state since GetCluster doesn't return them.
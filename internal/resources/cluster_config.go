@@ -108,7 +108,7 @@ func (r *ClusterConfigChangeResource) Configure(ctx context.Context, req resourc
 	if providerData.CloudClient == nil {
 		resp.Diagnostics.AddError(
 			"Cloud Management API Not Configured",
-			"The cloud_management_api_key must be configured in the provider to manage cluster configuration changes.",
+			"The cloud_api_key must be configured in the provider to manage cluster configuration changes.",
 		)
 		return
 	}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// clusterReadState builds the state of a cluster created with name "search",
+// 8 GB of memory and auto_upgrade_capacity off.
+func clusterReadState(t *testing.T, r *ClusterResource) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	data := ClusterResourceModel{}
+	r.updateModelFromCluster(&data, &client.Cluster{
+		ID:                     "cluster-abc",
+		Name:                   "search",
+		Memory:                 "8_gb",
+		VCPU:                   "2_vcpus_4_hr_burst_per_day",
+		HighAvailability:       "no",
+		TypesenseServerVersion: "29.0",
+		Regions:                []string{"oregon"},
+		Status:                 "in_service",
+		APIKeys:                &client.ClusterAPIKeys{Admin: "admin-key", SearchOnly: "search-key"},
+	})
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+	return state
+}
+
+func clusterCloudClient(t *testing.T, cluster *client.Cluster) *client.CloudClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/clusters/cluster-abc" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if cluster == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(cluster)
+	}))
+	t.Cleanup(server.Close)
+
+	c := client.NewCloudClient("test-key")
+	c.SetBaseURL(server.URL)
+	return c
+}
+
+func TestClusterReadReportsConsoleChanges(t *testing.T) {
+	ctx := context.Background()
+	r := &ClusterResource{client: clusterCloudClient(t, &client.Cluster{
+		ID:                     "cluster-abc",
+		Name:                   "search-renamed",
+		Memory:                 "16_gb",
+		VCPU:                   "4_vcpus",
+		HighAvailability:       "yes",
+		TypesenseServerVersion: "30.0",
+		Regions:                []string{"oregon"},
+		Status:                 "in_service",
+		AutoUpgradeCapacity:    true,
+	})}
+	state := clusterReadState(t, r)
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var data ClusterResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	got := map[string]string{
+		"name":                     data.Name.ValueString(),
+		"memory":                   data.Memory.ValueString(),
+		"vcpu":                     data.VCPU.ValueString(),
+		"high_availability":        data.HighAvailability.ValueString(),
+		"typesense_server_version": data.TypesenseServerVersion.ValueString(),
+		"admin_api_key":            data.AdminAPIKey.ValueString(),
+	}
+	want := map[string]string{
+		"name":                     "search-renamed",
+		"memory":                   "16_gb",
+		"vcpu":                     "4_vcpus",
+		"high_availability":        "yes",
+		"typesense_server_version": "30.0",
+		"admin_api_key":            "admin-key",
+	}
+	for attribute, value := range want {
+		if got[attribute] != value {
+			t.Errorf("%s = %q, want %q", attribute, got[attribute], value)
+		}
+	}
+	if !data.AutoUpgradeCapacity.ValueBool() {
+		t.Error("auto_upgrade_capacity = false, want the console's true")
+	}
+}
+
+func TestClusterReadRemovesDeletedCluster(t *testing.T) {
+	for name, cluster := range map[string]*client.Cluster{
+		"not found":  nil,
+		"terminated": {ID: "cluster-abc", Name: "search", Status: "terminated"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &ClusterResource{client: clusterCloudClient(t, cluster)}
+			state := clusterReadState(t, r)
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Error("the deleted cluster is still in state")
+			}
+		})
+	}
+}
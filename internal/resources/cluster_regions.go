@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clusterRegions is the set of region codes the Typesense Cloud cluster API
+// was known to accept. The API rejects anything else only after the create
+// request has been submitted, so the cluster resource warns about other codes
+// at plan time. Typesense Cloud adds regions over time, so the list may lag.
+var clusterRegions = map[string]struct{}{
+	"n_virginia":   {},
+	"ohio":         {},
+	"n_california": {},
+	"oregon":       {},
+	"canada":       {},
+	"calgary":      {},
+	"sao_paulo":    {},
+	"ireland":      {},
+	"london":       {},
+	"frankfurt":    {},
+	"paris":        {},
+	"stockholm":    {},
+	"milan":        {},
+	"spain":        {},
+	"zurich":       {},
+	"bahrain":      {},
+	"uae":          {},
+	"tel_aviv":     {},
+	"cape_town":    {},
+	"mumbai":       {},
+	"hyderabad":    {},
+	"singapore":    {},
+	"jakarta":      {},
+	"hong_kong":    {},
+	"tokyo":        {},
+	"osaka":        {},
+	"seoul":        {},
+	"sydney":       {},
+	"melbourne":    {},
+}
+
+// clusterRegionNames returns the known region codes in sorted order.
+func clusterRegionNames() []string {
+	names := make([]string, 0, len(clusterRegions))
+	for name := range clusterRegions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// clusterRegionsValidator warns about region codes that are not among the
+// known Typesense Cloud regions. It only warns, so a region added after this
+// list was written can still be used.
+type clusterRegionsValidator struct{}
+
+var _ validator.List = clusterRegionsValidator{}
+
+func (v clusterRegionsValidator) Description(ctx context.Context) string {
+	return "each region should be a Typesense Cloud region code"
+}
+
+func (v clusterRegionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v clusterRegionsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, elem := range req.ConfigValue.Elements() {
+		region, ok := elem.(types.String)
+		if !ok || region.IsNull() || region.IsUnknown() {
+			continue
+		}
+		if _, known := clusterRegions[region.ValueString()]; known {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path.AtListIndex(i),
+			"Unknown Cluster Region",
+			fmt.Sprintf("%q is not a known Typesense Cloud region. Known regions are: %s. Typesense Cloud will reject the cluster at apply if the region does not exist.",
+				region.ValueString(), strings.Join(clusterRegionNames(), ", ")),
+		)
+	}
+}
@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestClusterRegionsValidator(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        types.List
+		wantWarnings int
+	}{
+		{name: "known regions", value: regionList("n_virginia", "oregon"), wantWarnings: 0},
+		{name: "aws style code", value: regionList("us-east-1"), wantWarnings: 1},
+		{name: "one bad entry among good ones", value: regionList("frankfurt", "mars", "tokyo"), wantWarnings: 1},
+		{name: "null list", value: types.ListNull(types.StringType), wantWarnings: 0},
+		{name: "unknown list", value: types.ListUnknown(types.StringType), wantWarnings: 0},
+		{
+			name:         "unknown element",
+			value:        types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+			wantWarnings: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ListRequest{Path: path.Root("regions"), ConfigValue: tt.value}
+			var resp validator.ListResponse
+
+			clusterRegionsValidator{}.ValidateList(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tt.wantWarnings {
+				t.Fatalf("got %d warnings, want %d: %v", got, tt.wantWarnings, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestClusterRegionsValidatorPointsAtUnknownElement(t *testing.T) {
+	req := validator.ListRequest{Path: path.Root("regions"), ConfigValue: regionList("oregon", "us-west-2")}
+	var resp validator.ListResponse
+
+	clusterRegionsValidator{}.ValidateList(context.Background(), req, &resp)
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || resp.Diagnostics.HasError() {
+		t.Fatalf("got %v, want a single warning", resp.Diagnostics)
+	}
+	if !strings.Contains(warnings[0].Detail(), `"us-west-2"`) || !strings.Contains(warnings[0].Detail(), "n_virginia") {
+		t.Errorf("warning detail = %q, want the unknown code and the known region list", warnings[0].Detail())
+	}
+}
+
+func regionList(regions ...string) types.List {
+	values := make([]attr.Value, len(regions))
+	for i, r := range regions {
+		values[i] = types.StringValue(r)
+	}
+	return types.ListValueMust(types.StringType, values)
+}
@@ -4,20 +4,27 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/alanm/terraform-provider-typesense/internal/client"
 	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
 	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &CollectionResource{}
@@ -30,22 +37,33 @@ func NewCollectionResource() resource.Resource {
 
 // CollectionResource defines the resource implementation.
 type CollectionResource struct {
-	client *client.ServerClient
+	client         *client.ServerClient
+	featureChecker version.FeatureChecker
+	prefix         collectionNamePrefix
 }
 
 // CollectionResourceModel describes the resource data model.
 type CollectionResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	Fields              types.List   `tfsdk:"field"`
-	DefaultSortingField types.String `tfsdk:"default_sorting_field"`
-	TokenSeparators     types.List   `tfsdk:"token_separators"`
-	SymbolsToIndex      types.List   `tfsdk:"symbols_to_index"`
-	EnableNestedFields  types.Bool   `tfsdk:"enable_nested_fields"`
-	NumDocuments        types.Int64  `tfsdk:"num_documents"`
-	CreatedAt           types.Int64  `tfsdk:"created_at"`
-	Metadata            types.String `tfsdk:"metadata"`
-	VoiceQueryModel     types.String `tfsdk:"voice_query_model"`
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Fields               types.List   `tfsdk:"field"`
+	DefaultSortingField  types.String `tfsdk:"default_sorting_field"`
+	TokenSeparators      types.List   `tfsdk:"token_separators"`
+	SymbolsToIndex       types.List   `tfsdk:"symbols_to_index"`
+	EnableNestedFields   types.Bool   `tfsdk:"enable_nested_fields"`
+	NumDocuments         types.Int64  `tfsdk:"num_documents"`
+	CreatedAt            types.Int64  `tfsdk:"created_at"`
+	LastModifiedAt       types.String `tfsdk:"last_modified_at"`
+	Metadata             types.String `tfsdk:"metadata"`
+	VoiceQueryModel      types.String `tfsdk:"voice_query_model"`
+	SynonymSets          types.List   `tfsdk:"synonym_sets"`
+	CurationSets         types.List   `tfsdk:"curation_sets"`
+	ForceDestroy         types.Bool   `tfsdk:"force_destroy"`
+	RenameViaReindex     types.Bool   `tfsdk:"rename_via_reindex"`
+	ReindexOnEmbedChange types.Bool   `tfsdk:"reindex_on_embed_change"`
+	SchemaFrom           types.String `tfsdk:"schema_from"`
+	SkipDocumentCount    types.Bool   `tfsdk:"skip_document_count"`
+	TruncateOnDestroy    types.Bool   `tfsdk:"truncate_on_destroy"`
 }
 
 // CollectionFieldModel describes a field in the collection schema
@@ -73,9 +91,11 @@ type CollectionFieldModel struct {
 
 // embedModelConfigAttrTypes defines the attribute types for the model_config nested object
 var embedModelConfigAttrTypes = map[string]attr.Type{
-	"model_name": types.StringType,
-	"api_key":    types.StringType,
-	"url":        types.StringType,
+	"model_name":      types.StringType,
+	"api_key":         types.StringType,
+	"url":             types.StringType,
+	"indexing_prefix": types.StringType,
+	"query_prefix":    types.StringType,
 }
 
 // embedAttrTypes defines the attribute types for the embed nested object
@@ -124,21 +144,21 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 		Description: "Manages a Typesense collection.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier for the collection (same as name).",
+				Description: "Unique identifier for the collection: its name on the server, including any collection_name_prefix.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					collectionIDFromName{},
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the collection.",
+				Description: "The name of the collection, without the provider's collection_name_prefix. Changing it replaces the collection, unless rename_via_reindex is true.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					collectionNameRequiresReplace(),
 				},
 			},
 			"default_sorting_field": schema.StringAttribute{
-				Description: "The default field to sort results by.",
+				Description: "The default field to sort results by. Must name a declared int32, int64 or float field, or a field with sort = true.",
 				Optional:    true,
 			},
 			"token_separators": schema.ListAttribute{
@@ -152,31 +172,94 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 				ElementType: types.StringType,
 			},
 			"enable_nested_fields": schema.BoolAttribute{
-				Description: "Enable nested fields support.",
+				Description: "Enable nested fields support. Typesense cannot toggle this on an existing collection, so changing it replaces the collection.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
 			"num_documents": schema.Int64Attribute{
-				Description: "Number of documents in the collection.",
+				Description: "Number of documents in the collection. Null when skip_document_count is true.",
 				Computed:    true,
 			},
+			"skip_document_count": schema.BoolAttribute{
+				Description: "When true, num_documents is not recorded and stays null, so refreshes of large, busy collections do not store a count that changes on every read. " +
+					"Typesense still reports the count with every schema read; only the state is affected.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"created_at": schema.Int64Attribute{
 				Description: "Timestamp when the collection was created.",
 				Computed:    true,
 			},
+			"last_modified_at": schema.StringAttribute{
+				Description: "Time (RFC 3339, UTC) of the last schema or metadata update the provider applied to the collection. " +
+					"Typesense does not record this, so it is tracked in Terraform state only and is null until the first update.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"metadata": schema.StringAttribute{
-				Description: "Custom JSON metadata for the collection. Must be a valid JSON string.",
-				Optional:    true,
+				Description: "Custom JSON metadata for the collection. Must be a valid JSON string. " +
+					"Removing it clears the metadata on the server, and changes made outside Terraform show up as drift.",
+				Optional: true,
 			},
 			"voice_query_model": schema.StringAttribute{
 				Description: "Model for voice search (e.g., \"ts/whisper/base.en\").",
 				Optional:    true,
 			},
+			"synonym_sets": schema.ListAttribute{
+				Description: "Names of synonym sets applied to searches on this collection. Requires Typesense v30 or later.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"curation_sets": schema.ListAttribute{
+				Description: "Names of curation sets applied to searches on this collection. Requires Typesense v30 or later.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "When true, aliases pointing at this collection are deleted before the collection itself, so destroying it does not leave dangling aliases.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"truncate_on_destroy": schema.BoolAttribute{
+				Description: "When true, every document is deleted, and the number deleted logged, before the collection itself is deleted on destroy. Requires Typesense v28 or later.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"rename_via_reindex": schema.BoolAttribute{
+				Description: "When true, changing name creates a collection with the new name, copies every document into it and deletes the old one, instead of replacing the collection empty. " +
+					"The copy reads and rewrites every document and briefly needs storage for both collections; aliases are not repointed.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"reindex_on_embed_change": schema.BoolAttribute{
+				Description: "When true, changing the embed block of a field (from, model_name or url) drops and re-adds the field, so Typesense regenerates the embedding of every stored document with the new model. " +
+					"This calls the embedding model once per document. When false, such a change is rejected at plan time, since the stored vectors would no longer match the model used for queries.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"schema_from": schema.StringAttribute{
+				Description: "Name of an existing collection whose fields are copied when this collection is created, e.g. to create products_v2 with the schema of products. " +
+					"When set, field blocks are ignored and the field list is not managed after creation. Changing it replaces the collection.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"field": schema.ListNestedBlock{
-				Description: "Schema fields for the collection.",
+				Description: "Schema fields for the collection. At least one is required unless schema_from is set.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
@@ -184,8 +267,9 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 							Required:    true,
 						},
 						"type": schema.StringAttribute{
-							Description: "The data type of the field (string, string[], int32, int64, float, bool, geopoint, geopoint[], object, object[], auto, string*, float[]).",
-							Required:    true,
+							Description: "The data type of the field (string, string[], int32, int64, float, bool, geopoint, geopoint[], object, object[], auto, string*, float[], image). " +
+								"For auto and string* fields, the type the server detects from documents is not treated as a change.",
+							Required: true,
 						},
 						"facet": schema.BoolAttribute{
 							Description: "Enable faceting on this field.",
@@ -194,10 +278,9 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 							Default:     booldefault.StaticBool(false),
 						},
 						"optional": schema.BoolAttribute{
-							Description: "Whether the field is optional.",
+							Description: "Whether the field is optional. When unset, the server decides; it makes some fields optional on its own, e.g. fields with index = false.",
 							Optional:    true,
 							Computed:    true,
-							Default:     booldefault.StaticBool(false),
 						},
 						"index": schema.BoolAttribute{
 							Description: "Whether to index this field.",
@@ -206,9 +289,13 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 							Default:     booldefault.StaticBool(true),
 						},
 						"sort": schema.BoolAttribute{
-							Description: "Enable sorting on this field. Typesense enables sorting by default for numeric fields (int32, int64, float).",
-							Optional:    true,
-							Computed:    true,
+							Description: "Enable sorting on this field. Typesense enables sorting by default for int32, int64, float, bool and geopoint fields, and not for string and string[] fields; " +
+								"when unset, the plan shows that default instead of a value known after apply.",
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								fieldSortDefault{},
+							},
 						},
 						"infix": schema.BoolAttribute{
 							Description: "Enable infix search on this field.",
@@ -217,17 +304,20 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 							Default:     booldefault.StaticBool(false),
 						},
 						"locale": schema.StringAttribute{
-							Description: "Locale for language-specific processing.",
+							Description: "Locale for language-specific processing, e.g. ja or zh. Case and the - or _ separator are not significant, so the value is kept as written when the server reports it in another form.",
 							Optional:    true,
 						},
 						"num_dim": schema.Int64Attribute{
-							Description: "Number of vector dimensions. When set, a float[] field becomes a vector field.",
+							Description: "Number of vector dimensions. When set, a float[] field becomes a vector field. On an embed field it can be left unset; the model determines it.",
 							Optional:    true,
 						},
 						"vec_dist": schema.StringAttribute{
-							Description: "Vector distance metric: \"cosine\" or \"ip\". Default: \"cosine\".",
+							Description: "Vector distance metric: \"cosine\" or \"ip\". Default: \"cosine\". Only valid on vector fields (num_dim > 0 or embed).",
 							Optional:    true,
 							Computed:    true,
+							Validators: []validator.String{
+								vecDistValidator{},
+							},
 						},
 						"embed": schema.SingleNestedAttribute{
 							Description: "Auto-embedding configuration for this field.",
@@ -255,14 +345,23 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 											Description: "Custom endpoint URL for the embedding model.",
 											Optional:    true,
 										},
+										"indexing_prefix": schema.StringAttribute{
+											Description: "Text prepended to document fields before embedding them, for instruction-tuned models (e.g. \"passage:\" for e5).",
+											Optional:    true,
+										},
+										"query_prefix": schema.StringAttribute{
+											Description: "Text prepended to search queries before embedding them, for instruction-tuned models (e.g. \"query:\" for e5).",
+											Optional:    true,
+										},
 									},
 								},
 							},
 						},
 						"hnsw_params": schema.SingleNestedAttribute{
-							Description: "HNSW algorithm tuning parameters for vector fields.",
-							Optional:    true,
-							Computed:    true,
+							Description: "HNSW algorithm tuning parameters for vector fields, applied when the index is built. " +
+								"The search-time ef is not a schema setting; pass it per query in vector_query, e.g. embedding:([], ef: 100).",
+							Optional: true,
+							Computed: true,
 							Attributes: map[string]schema.Attribute{
 								"ef_construction": schema.Int64Attribute{
 									Description: "HNSW ef_construction parameter. Default: 200.",
@@ -277,10 +376,10 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 							},
 						},
 						"reference": schema.StringAttribute{
-							Description: "Reference to another collection field for JOINs (e.g., \"authors.id\"). Cannot be added via update; requires collection recreation.",
+							Description: "Reference to another collection field for JOINs (e.g., \"authors.id\"). A reference without a field, e.g. \"authors\", is the same as \"authors.id\". Cannot be added via update; requires collection recreation.",
 							Optional:    true,
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
+								fieldReferenceRequiresReplace(),
 							},
 						},
 						"async_reference": schema.BoolAttribute{
@@ -304,12 +403,12 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 							Computed:    true,
 						},
 						"token_separators": schema.ListAttribute{
-							Description: "Field-level token splitting characters.",
+							Description: "Field-level token splitting characters. Typesense cannot change them in place, so changing them drops and re-adds the field.",
 							Optional:    true,
 							ElementType: types.StringType,
 						},
 						"symbols_to_index": schema.ListAttribute{
-							Description: "Field-level special characters to index.",
+							Description: "Field-level special characters to index. Typesense cannot change them in place, so changing them drops and re-adds the field.",
 							Optional:    true,
 							ElementType: types.StringType,
 						},
@@ -344,6 +443,8 @@ func (r *CollectionResource) Configure(ctx context.Context, req resource.Configu
 	}
 
 	r.client = providerData.ServerClient
+	r.featureChecker = providerData.FeatureChecker
+	r.prefix = collectionNamePrefix(providerData.CollectionNamePrefix)
 }
 
 func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -361,12 +462,15 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Creating is not an alter; last_modified_at starts out null.
+	data.LastModifiedAt = types.StringNull()
+
 	created, err := r.client.CreateCollection(ctx, collection)
 	if err != nil {
 		// Check if the collection already exists (HTTP 409 Conflict)
 		// If so, adopt the existing collection into state instead of failing
-		if strings.Contains(err.Error(), "status 409") {
-			existing, getErr := r.client.GetCollection(ctx, data.Name.ValueString())
+		if isConflictError(err) {
+			existing, getErr := r.client.GetCollection(ctx, collection.Name)
 			if getErr != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Collection already exists but failed to read it: %s", getErr))
 				return
@@ -384,6 +488,18 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Not every server echoes created_at in the POST response; read the
+	// collection back so created_at and num_documents are never left unset.
+	if created.CreatedAt == 0 {
+		stored, getErr := r.client.GetCollection(ctx, collection.Name)
+		if getErr != nil {
+			resp.Diagnostics.AddWarning("Collection Not Read Back",
+				fmt.Sprintf("Collection %s was created, but reading it back failed, so created_at may be 0 until the next refresh: %s", collection.Name, getErr))
+		} else if stored != nil {
+			created = stored
+		}
+	}
+
 	r.updateModelFromCollection(ctx, &data, created)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -398,7 +514,7 @@ func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	collection, err := r.client.GetCollection(ctx, data.Name.ValueString())
+	collection, err := r.client.GetCollection(ctx, r.prefix.serverName(data.Name.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read collection: %s", err))
 		return
@@ -425,6 +541,19 @@ func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// ModifyPlan leaves last_modified_at unknown only when this update alters
+	// the collection; it is set below once the alter succeeds.
+	if data.LastModifiedAt.IsUnknown() {
+		data.LastModifiedAt = state.LastModifiedAt
+	}
+
+	// The name only changes in place when rename_via_reindex is set; the new
+	// collection is created from the full plan, so no field diff is needed.
+	if data.Name.ValueString() != state.Name.ValueString() {
+		r.renameViaReindex(ctx, r.prefix.serverName(state.Name.ValueString()), &data, resp)
+		return
+	}
+
 	// Get planned and current fields
 	plannedFields, diags := r.extractFields(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -438,28 +567,116 @@ func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Calculate fields to add and drop
 	var fieldsToUpdate []client.CollectionField
+	if data.SchemaFrom.IsNull() {
+		if names := embedChangedFields(currentFields, plannedFields); len(names) > 0 && !data.ReindexOnEmbedChange.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("field"), "Embedding Change Requires Re-embedding", embedChangeDetail(names))
+			return
+		}
+		// A changed embed is applied like any other changed field: dropped
+		// and re-added, which makes Typesense re-embed every stored document.
+		fieldsToUpdate = collectionFieldChanges(currentFields, plannedFields)
+	}
 
-	// Find fields to add (in planned but not in current)
-	currentFieldNames := make(map[string]bool)
-	for _, f := range currentFields {
-		currentFieldNames[f.Name] = true
+	// Build the update request
+	update := &client.Collection{
+		Fields: fieldsToUpdate,
+	}
+
+	if len(fieldsToUpdate) > 0 {
+		_, err := r.client.UpdateCollection(ctx, r.prefix.serverName(data.Name.ValueString()), update)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update collection: %s", err))
+			return
+		}
+		data.LastModifiedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 	}
 
-	for _, f := range plannedFields {
-		if !currentFieldNames[f.Name] {
+	if !data.SynonymSets.Equal(state.SynonymSets) || !data.CurationSets.Equal(state.CurationSets) {
+		var synonymSets, curationSets []string
+		resp.Diagnostics.Append(data.SynonymSets.ElementsAs(ctx, &synonymSets, false)...)
+		resp.Diagnostics.Append(data.CurationSets.ElementsAs(ctx, &curationSets, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		_, err := r.client.UpdateCollectionSets(ctx, r.prefix.serverName(data.Name.ValueString()), synonymSets, curationSets)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update collection synonym and curation sets: %s", err))
+			return
+		}
+		data.LastModifiedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+
+	// Metadata is sent on its own, and only when it changes, so that removing
+	// it from the configuration clears it on the server.
+	if metadataChanged(data.Metadata, state.Metadata) {
+		var metadata client.JSONObject
+		if !data.Metadata.IsNull() {
+			if err := json.Unmarshal([]byte(data.Metadata.ValueString()), &metadata); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("metadata"), "Invalid Metadata", fmt.Sprintf("The metadata attribute must be a valid JSON string: %s", err))
+				return
+			}
+		}
+		_, err := r.client.UpdateCollectionMetadata(ctx, r.prefix.serverName(data.Name.ValueString()), metadata)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update collection metadata: %s", err))
+			return
+		}
+		data.LastModifiedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+
+	// Re-read the collection to get the updated state
+	collection, err := r.client.GetCollection(ctx, r.prefix.serverName(data.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read collection after update: %s", err))
+		return
+	}
+
+	r.updateModelFromCollection(ctx, &data, collection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// collectionFieldChanges returns the schema update that turns current into
+// planned: new fields to add, removed fields marked drop, and changed fields
+// dropped and re-added under the same name, which Typesense applies by
+// reindexing the stored values. An embed field whose only change is its
+// query_prefix is sent as it is, without a drop. A renamed field shows up as
+// an add of the new name and a drop of the old one, sent together in one
+// PATCH; Typesense does not copy values from the old field.
+func collectionFieldChanges(current, planned []client.CollectionField) []client.CollectionField {
+	var fieldsToUpdate []client.CollectionField
+
+	currentByName := make(map[string]client.CollectionField)
+	for _, f := range current {
+		currentByName[f.Name] = f
+	}
+
+	for _, f := range planned {
+		existing, ok := currentByName[f.Name]
+		if !ok {
+			// Find fields to add (in planned but not in current)
+			fieldsToUpdate = append(fieldsToUpdate, f)
+			continue
+		}
+		// The implicit id field is not part of the server schema.
+		if f.Name == "id" {
+			continue
+		}
+		if collectionFieldChanged(existing, f) {
+			fieldsToUpdate = append(fieldsToUpdate, client.CollectionField{Name: f.Name, Drop: true}, withServerDefaultSort(f))
+		} else if queryPrefixChanged(existing.Embed, f.Embed) {
 			fieldsToUpdate = append(fieldsToUpdate, f)
 		}
 	}
 
 	// Find fields to drop (in current but not in planned)
 	plannedFieldNames := make(map[string]bool)
-	for _, f := range plannedFields {
+	for _, f := range planned {
 		plannedFieldNames[f.Name] = true
 	}
 
-	for _, f := range currentFields {
+	for _, f := range current {
 		if !plannedFieldNames[f.Name] {
 			fieldsToUpdate = append(fieldsToUpdate, client.CollectionField{
 				Name: f.Name,
@@ -468,37 +685,114 @@ func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 
-	// Build the update request
-	update := &client.Collection{
-		Fields: fieldsToUpdate,
-	}
+	return fieldsToUpdate
+}
 
-	// Handle collection-level metadata changes
-	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
-		var metadata map[string]any
-		if err := json.Unmarshal([]byte(data.Metadata.ValueString()), &metadata); err == nil {
-			update.Metadata = metadata
-		}
+// collectionFieldChanged reports whether the planned definition of a field
+// differs from its current one. Optional pointer attributes left unset in the
+// plan (e.g. sort and optional, which the server computes) are not compared,
+// and neither is an unset vec_dist, which the server defaults to cosine.
+func collectionFieldChanged(current, planned client.CollectionField) bool {
+	boolPtrChanged := func(cur, plan *bool) bool {
+		return plan != nil && (cur == nil || *cur != *plan)
+	}
+
+	return current.Type != planned.Type ||
+		current.Facet != planned.Facet ||
+		current.Infix != planned.Infix ||
+		!equivalentFieldLocale(current.Locale, planned.Locale) ||
+		numDimChanged(current, planned) ||
+		(planned.VecDist != "" && current.VecDist != planned.VecDist) ||
+		!equivalentFieldReference(current.Reference, planned.Reference) ||
+		embedChanged(current.Embed, planned.Embed) ||
+		boolPtrChanged(current.Optional, planned.Optional) ||
+		boolPtrChanged(current.Index, planned.Index) ||
+		boolPtrChanged(current.Sort, planned.Sort) ||
+		boolPtrChanged(current.Stem, planned.Stem) ||
+		boolPtrChanged(current.RangeIndex, planned.RangeIndex) ||
+		boolPtrChanged(current.Store, planned.Store) ||
+		!slices.Equal(current.TokenSeparators, planned.TokenSeparators) ||
+		!slices.Equal(current.SymbolsToIndex, planned.SymbolsToIndex)
+}
+
+// equivalentFieldLocale reports whether two locales name the same language.
+// Case and the separator of a region or script suffix are not significant,
+// so "ja", "JA" and "zh-Hant"/"zh_hant" compare equal however the server
+// reports them.
+func equivalentFieldLocale(a, b string) bool {
+	normalize := func(locale string) string {
+		return strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
 	}
+	return strings.EqualFold(normalize(a), normalize(b))
+}
 
-	if len(fieldsToUpdate) > 0 || update.Metadata != nil {
-		_, err := r.client.UpdateCollection(ctx, data.Name.ValueString(), update)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update collection: %s", err))
-			return
-		}
+// numDimChanged reports whether the planned num_dim of a field differs from
+// its current one. An embed field without num_dim takes it from the model,
+// so only an explicit value is compared there.
+func numDimChanged(current, planned client.CollectionField) bool {
+	if planned.Embed != nil && planned.NumDim == nil {
+		return false
 	}
+	if current.NumDim == nil || planned.NumDim == nil {
+		return current.NumDim != planned.NumDim
+	}
+	return *current.NumDim != *planned.NumDim
+}
 
-	// Re-read the collection to get the updated state
-	collection, err := r.client.GetCollection(ctx, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read collection after update: %s", err))
-		return
+// embedChanged reports whether a field's embedding source, model or
+// indexing prefix differ, i.e. anything the stored vectors depend on. The
+// api_key is not compared: rotating it does not change the vectors, and the
+// server may not return it. Neither is the query_prefix, which only applies
+// to search queries; see queryPrefixChanged.
+func embedChanged(current, planned *client.FieldEmbed) bool {
+	if current == nil || planned == nil {
+		return current != planned
+	}
+	return !slices.Equal(current.From, planned.From) ||
+		current.ModelConfig.ModelName != planned.ModelConfig.ModelName ||
+		current.ModelConfig.URL != planned.ModelConfig.URL ||
+		current.ModelConfig.IndexingPrefix != planned.ModelConfig.IndexingPrefix
+}
+
+// queryPrefixChanged reports whether the query_prefix of an embed field
+// differs. Stored vectors do not depend on it, so it is updated without
+// re-embedding the documents.
+func queryPrefixChanged(current, planned *client.FieldEmbed) bool {
+	return current != nil && planned != nil && current.ModelConfig.QueryPrefix != planned.ModelConfig.QueryPrefix
+}
+
+// embedChangedFields returns the names of existing fields whose embed
+// configuration differs between current and planned. Documents stored in
+// them hold vectors from the old model until the field is re-embedded.
+func embedChangedFields(current, planned []client.CollectionField) []string {
+	currentByName := make(map[string]client.CollectionField)
+	for _, f := range current {
+		currentByName[f.Name] = f
 	}
 
-	r.updateModelFromCollection(ctx, &data, collection)
+	var names []string
+	for _, f := range planned {
+		if existing, ok := currentByName[f.Name]; ok && embedChanged(existing.Embed, f.Embed) {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+// withServerDefaultSort sets sort explicitly on a re-added field that leaves
+// it unset, when Typesense makes new fields of that type sortable (see
+// defaultFieldSort). The state read back after the update then matches what
+// the plan assumed and what a fresh create would have produced. Types the
+// server leaves unsortable, and unindexed fields, are sent as they are.
+func withServerDefaultSort(f client.CollectionField) client.CollectionField {
+	if f.Sort != nil || (f.Index != nil && !*f.Index) {
+		return f
+	}
+	if defaultFieldSort[f.Type] {
+		sort := true
+		f.Sort = &sort
+	}
+	return f
 }
 
 func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -510,23 +804,96 @@ func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := r.client.DeleteCollection(ctx, data.Name.ValueString())
+	name := r.prefix.serverName(data.Name.ValueString())
+
+	if data.ForceDestroy.ValueBool() {
+		aliases, err := r.aliasesTargeting(ctx, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list aliases for collection %s: %s", name, err))
+			return
+		}
+		for _, alias := range aliases {
+			if err := r.client.DeleteCollectionAlias(ctx, alias); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alias %s pointing at collection %s: %s", alias, name, err))
+				return
+			}
+		}
+	} else if data.TruncateOnDestroy.ValueBool() {
+		// An alias makes the server reject the delete below. Stop before
+		// truncating, so the alias is not left pointing at an emptied
+		// collection.
+		aliases, err := r.aliasesTargeting(ctx, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list aliases for collection %s: %s", name, err))
+			return
+		}
+		if len(aliases) > 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete collection %s: it is still the target of alias(es) %s. Delete or repoint them first, or set force_destroy = true to remove them automatically. No documents were deleted.",
+				name, strings.Join(aliases, ", ")))
+			return
+		}
+	}
+
+	if data.TruncateOnDestroy.ValueBool() {
+		deleted, err := r.client.TruncateDocuments(ctx, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete the documents of collection %s: %s", name, err))
+			return
+		}
+		tflog.Info(ctx, "Deleted documents before deleting collection", map[string]any{"collection": name, "num_deleted": deleted})
+	}
+
+	err := r.client.DeleteCollection(ctx, name)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete collection: %s", err))
+		detail := fmt.Sprintf("Unable to delete collection: %s", err)
+		// Name any aliases still pointing here; they are the usual reason a
+		// delete is rejected.
+		if aliases, aliasErr := r.aliasesTargeting(ctx, name); aliasErr == nil && len(aliases) > 0 {
+			detail += fmt.Sprintf("\n\nThe collection is still the target of alias(es) %s. Delete or repoint them first, or set force_destroy = true to remove them automatically.",
+				strings.Join(aliases, ", "))
+		}
+		resp.Diagnostics.AddError("Client Error", detail)
 		return
 	}
 }
 
+// aliasesTargeting returns the names of the aliases that point at collection.
+func (r *CollectionResource) aliasesTargeting(ctx context.Context, collection string) ([]string, error) {
+	aliases, err := r.client.ListCollectionAliases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, alias := range aliases {
+		if alias.CollectionName == collection {
+			names = append(names, alias.Name)
+		}
+	}
+	return names, nil
+}
+
 func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	// The import ID may be the collection name with or without the
+	// provider's collection_name_prefix.
+	name := r.prefix.configName(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.prefix.serverName(name))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	// force_destroy, rename_via_reindex, reindex_on_embed_change and the
+	// other settings below only live in Terraform; import them at their
+	// defaults.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_destroy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rename_via_reindex"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reindex_on_embed_change"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_document_count"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("truncate_on_destroy"), false)...)
 }
 
 func (r *CollectionResource) modelToCollection(ctx context.Context, data *CollectionResourceModel) (*client.Collection, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	collection := &client.Collection{
-		Name:               data.Name.ValueString(),
+		Name:               r.prefix.serverName(data.Name.ValueString()),
 		EnableNestedFields: data.EnableNestedFields.ValueBool(),
 	}
 
@@ -550,7 +917,7 @@ func (r *CollectionResource) modelToCollection(ctx context.Context, data *Collec
 
 	// Extract metadata JSON
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
-		var metadata map[string]any
+		var metadata client.JSONObject
 		if err := json.Unmarshal([]byte(data.Metadata.ValueString()), &metadata); err != nil {
 			diags.AddError("Invalid Metadata", fmt.Sprintf("The metadata attribute must be a valid JSON string: %s", err))
 		} else {
@@ -563,10 +930,24 @@ func (r *CollectionResource) modelToCollection(ctx context.Context, data *Collec
 		collection.VoiceQueryModel = data.VoiceQueryModel.ValueString()
 	}
 
-	// Extract fields
-	fields, d := r.extractFields(ctx, data)
-	diags.Append(d...)
-	collection.Fields = fields
+	// Extract synonym and curation set associations
+	if !data.SynonymSets.IsNull() {
+		diags.Append(data.SynonymSets.ElementsAs(ctx, &collection.SynonymSets, false)...)
+	}
+	if !data.CurationSets.IsNull() {
+		diags.Append(data.CurationSets.ElementsAs(ctx, &collection.CurationSets, false)...)
+	}
+
+	// Extract fields, or copy them from schema_from
+	if !data.SchemaFrom.IsNull() {
+		fields, d := r.sourceSchemaFields(ctx, r.prefix.serverName(data.SchemaFrom.ValueString()))
+		diags.Append(d...)
+		collection.Fields = fields
+	} else {
+		fields, d := r.extractFields(ctx, data)
+		diags.Append(d...)
+		collection.Fields = fields
+	}
 
 	return collection, diags
 }
@@ -584,11 +965,17 @@ func (r *CollectionResource) extractFields(ctx context.Context, data *Collection
 
 	for _, fm := range fieldModels {
 		field := client.CollectionField{
-			Name:     fm.Name.ValueString(),
-			Type:     fm.Type.ValueString(),
-			Facet:    fm.Facet.ValueBool(),
-			Optional: fm.Optional.ValueBool(),
-			Infix:    fm.Infix.ValueBool(),
+			Name:  fm.Name.ValueString(),
+			Type:  fm.Type.ValueString(),
+			Facet: fm.Facet.ValueBool(),
+			Infix: fm.Infix.ValueBool(),
+		}
+
+		// Like sort, optional is only sent when configured, so the server can
+		// make fields optional on its own.
+		if !fm.Optional.IsNull() && !fm.Optional.IsUnknown() {
+			optional := fm.Optional.ValueBool()
+			field.Optional = &optional
 		}
 
 		if !fm.Index.IsNull() {
@@ -609,7 +996,8 @@ func (r *CollectionResource) extractFields(ctx context.Context, data *Collection
 
 		// Vector search attributes
 		if !fm.NumDim.IsNull() && !fm.NumDim.IsUnknown() {
-			field.NumDim = fm.NumDim.ValueInt64()
+			numDim := fm.NumDim.ValueInt64()
+			field.NumDim = &numDim
 		}
 
 		if !fm.VecDist.IsNull() && !fm.VecDist.IsUnknown() {
@@ -642,6 +1030,12 @@ func (r *CollectionResource) extractFields(ctx context.Context, data *Collection
 				if u, ok := mcAttrs["url"]; ok && !u.IsNull() && !u.IsUnknown() {
 					embed.ModelConfig.URL = u.(types.String).ValueString()
 				}
+				if ip, ok := mcAttrs["indexing_prefix"]; ok && !ip.IsNull() && !ip.IsUnknown() {
+					embed.ModelConfig.IndexingPrefix = ip.(types.String).ValueString()
+				}
+				if qp, ok := mcAttrs["query_prefix"]; ok && !qp.IsNull() && !qp.IsUnknown() {
+					embed.ModelConfig.QueryPrefix = qp.(types.String).ValueString()
+				}
 			}
 
 			field.Embed = embed
@@ -664,7 +1058,7 @@ func (r *CollectionResource) extractFields(ctx context.Context, data *Collection
 
 		// Reference / JOINs
 		if !fm.Reference.IsNull() && !fm.Reference.IsUnknown() {
-			field.Reference = fm.Reference.ValueString()
+			field.Reference = r.prefix.serverReference(fm.Reference.ValueString())
 		}
 		if !fm.AsyncReference.IsNull() && !fm.AsyncReference.IsUnknown() {
 			v := fm.AsyncReference.ValueBool()
@@ -711,7 +1105,7 @@ func (r *CollectionResource) extractFields(ctx context.Context, data *Collection
 
 func (r *CollectionResource) updateModelFromCollection(ctx context.Context, data *CollectionResourceModel, collection *client.Collection) {
 	data.ID = types.StringValue(collection.Name)
-	data.Name = types.StringValue(collection.Name)
+	data.Name = types.StringValue(r.prefix.configName(collection.Name))
 	// Handle empty string as null for default_sorting_field
 	if collection.DefaultSortingField != "" {
 		data.DefaultSortingField = types.StringValue(collection.DefaultSortingField)
@@ -719,19 +1113,25 @@ func (r *CollectionResource) updateModelFromCollection(ctx context.Context, data
 		data.DefaultSortingField = types.StringNull()
 	}
 	data.EnableNestedFields = types.BoolValue(collection.EnableNestedFields)
-	data.NumDocuments = types.Int64Value(collection.NumDocuments)
+	if data.SkipDocumentCount.ValueBool() {
+		data.NumDocuments = types.Int64Null()
+	} else {
+		data.NumDocuments = types.Int64Value(collection.NumDocuments)
+	}
 	data.CreatedAt = types.Int64Value(collection.CreatedAt)
 
-	// Convert collection-level metadata
-	if collection.Metadata != nil {
+	// Convert collection-level metadata. The configured JSON is kept while it
+	// matches the server's, so formatting and key order do not show as a
+	// diff; anything else is drift. Empty metadata is what a clear leaves.
+	if len(collection.Metadata) == 0 {
+		data.Metadata = types.StringNull()
+	} else if !metadataMatches(data.Metadata, collection.Metadata) {
 		metadataBytes, err := json.Marshal(collection.Metadata)
 		if err == nil {
 			data.Metadata = types.StringValue(string(metadataBytes))
 		} else {
 			data.Metadata = types.StringNull()
 		}
-	} else if data.Metadata.IsNull() || data.Metadata.IsUnknown() {
-		data.Metadata = types.StringNull()
 	}
 
 	// Convert voice query model
@@ -741,6 +1141,9 @@ func (r *CollectionResource) updateModelFromCollection(ctx context.Context, data
 		data.VoiceQueryModel = types.StringNull()
 	}
 
+	data.SynonymSets = setNamesValue(collection.SynonymSets, data.SynonymSets)
+	data.CurationSets = setNamesValue(collection.CurationSets, data.CurationSets)
+
 	// Convert token separators
 	if len(collection.TokenSeparators) > 0 {
 		separators := make([]types.String, len(collection.TokenSeparators))
@@ -759,47 +1162,194 @@ func (r *CollectionResource) updateModelFromCollection(ctx context.Context, data
 		data.SymbolsToIndex, _ = types.ListValueFrom(ctx, types.StringType, symbols)
 	}
 
+	// With schema_from the fields come from the source collection and are not
+	// managed, so the field blocks stay as configured.
+	if !data.SchemaFrom.IsNull() {
+		return
+	}
+
 	// Convert fields
 	fAttrTypes := fieldAttrTypes()
 
-	// Check if the original model had an 'id' field that we need to preserve.
-	// Typesense treats 'id' as an implicit field and doesn't return it in the schema.
+	// Fields are matched by name against the prior model, and kept in its
+	// order, so the server's ordering (re-added fields move to the end) and
+	// the implicit 'id' field, which the server never returns, do not cause
+	// index-based diffs.
+	var order []string
 	var idFieldValue attr.Value
+	declared := make(map[string]bool)
+	references := make(map[string]string)
+	locales := make(map[string]string)
+	numDims := make(map[string]bool)
+	autoTypes := make(map[string]string)
 	if !data.Fields.IsNull() && !data.Fields.IsUnknown() {
 		var existingFields []CollectionFieldModel
 		data.Fields.ElementsAs(ctx, &existingFields, false)
 		for _, ef := range existingFields {
-			if ef.Name.ValueString() == "id" {
+			name := ef.Name.ValueString()
+			if declared[name] {
+				continue
+			}
+			declared[name] = true
+			order = append(order, name)
+			references[name] = ef.Reference.ValueString()
+			locales[name] = ef.Locale.ValueString()
+			numDims[name] = !ef.NumDim.IsNull()
+			if isAutoFieldType(ef.Type.ValueString()) {
+				autoTypes[name] = ef.Type.ValueString()
+			}
+			if name == "id" {
 				idFieldValue = r.buildIdFieldObject(ctx, ef, fAttrTypes)
-				break
 			}
 		}
 	}
-
-	// Check if API response contains an 'id' field
-	apiHasIdField := false
-	for _, f := range collection.Fields {
-		if f.Name == "id" {
-			apiHasIdField = true
-			break
-		}
+	apiFields := withoutAutoDetectedFields(declaredCollectionFields(collection.Fields, declared), declared, autoTypes)
+	for i := range apiFields {
+		apiFields[i].Reference = r.prefix.configReference(apiFields[i].Reference)
 	}
 
-	// Build field values, prepending 'id' if it was in original model but not in API response
-	fieldValues := make([]attr.Value, 0, len(collection.Fields)+1)
-	if idFieldValue != nil && !apiHasIdField {
-		fieldValues = append(fieldValues, idFieldValue)
+	apiByName := make(map[string]client.CollectionField, len(apiFields))
+	for _, f := range apiFields {
+		apiByName[f.Name] = f
 	}
 
-	for _, f := range collection.Fields {
-		fieldObj := r.apiFieldToObjectValue(ctx, f, fAttrTypes)
-		fieldValues = append(fieldValues, fieldObj)
+	fieldValues := make([]attr.Value, 0, len(apiFields)+1)
+	for _, name := range order {
+		if f, ok := apiByName[name]; ok {
+			// Keep the reference as written when the server reports the same
+			// reference in another form.
+			if ref := references[name]; ref != "" && equivalentFieldReference(ref, f.Reference) {
+				f.Reference = ref
+			}
+			// Likewise for a locale the server reports in another case or form.
+			if locale := locales[name]; locale != "" && equivalentFieldLocale(locale, f.Locale) {
+				f.Locale = locale
+			}
+			// An auto field keeps its declared type once the server has
+			// detected a concrete one from the documents.
+			if t, ok := autoTypes[name]; ok {
+				f.Type = t
+			}
+			f = withoutDerivedNumDim(f, numDims[name])
+			fieldValues = append(fieldValues, r.apiFieldToObjectValue(ctx, f, fAttrTypes))
+			delete(apiByName, name)
+		} else if name == "id" {
+			fieldValues = append(fieldValues, idFieldValue)
+		}
+	}
+	// Fields the prior model does not know about keep the server's order.
+	for _, f := range apiFields {
+		if _, ok := apiByName[f.Name]; ok {
+			fieldValues = append(fieldValues, r.apiFieldToObjectValue(ctx, withoutDerivedNumDim(f, false), fAttrTypes))
+		}
 	}
 
 	fieldObjType := types.ObjectType{AttrTypes: fAttrTypes}
 	data.Fields, _ = types.ListValue(fieldObjType, fieldValues)
 }
 
+// withoutDerivedNumDim clears the num_dim the server reports for an embed
+// field, which it takes from the embedding model, unless the prior model set
+// num_dim explicitly. Configurations leave it out, and keeping the server's
+// value would make the applied state differ from the plan.
+func withoutDerivedNumDim(f client.CollectionField, declared bool) client.CollectionField {
+	if f.Embed != nil && !declared {
+		f.NumDim = nil
+	}
+	return f
+}
+
+// declaredCollectionFields drops the fields the server flattened out of
+// object fields on its own when enable_nested_fields is on, e.g. "person.name"
+// for an object field "person". These were never written in configuration, so
+// keeping them would make an imported collection differ from the config a user
+// writes for it. Flattened fields in declared, i.e. ones the configuration or
+// prior state lists explicitly, are kept.
+func declaredCollectionFields(fields []client.CollectionField, declared map[string]bool) []client.CollectionField {
+	var objectFields []string
+	for _, f := range fields {
+		if f.Type == "object" || f.Type == "object[]" {
+			objectFields = append(objectFields, f.Name)
+		}
+	}
+
+	isFlattened := func(f client.CollectionField) bool {
+		if f.Nested {
+			return true
+		}
+		for _, parent := range objectFields {
+			if strings.HasPrefix(f.Name, parent+".") {
+				return true
+			}
+		}
+		return false
+	}
+
+	result := make([]client.CollectionField, 0, len(fields))
+	for _, f := range fields {
+		if isFlattened(f) && !declared[f.Name] {
+			continue
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
+// setNamesValue converts the synonym or curation set names the server reports.
+// No sets is null unless the prior model held a list, so an explicit empty
+// list stays empty and removed sets show as drift.
+func setNamesValue(names []string, prior types.List) types.List {
+	if len(names) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ListNull(types.StringType)
+	}
+	values := make([]attr.Value, len(names))
+	for i, name := range names {
+		values[i] = types.StringValue(name)
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+// isAutoFieldType reports whether a field type lets the server detect the
+// actual type from the indexed documents.
+func isAutoFieldType(t string) bool {
+	return t == "auto" || t == "string*"
+}
+
+// withoutAutoDetectedFields drops the fields the server added for document
+// keys matched by a declared auto field whose name is a pattern, e.g. ".*" or
+// ".*_facet". Like flattened nested fields, these were never written in
+// configuration. Fields in declared are kept.
+func withoutAutoDetectedFields(fields []client.CollectionField, declared map[string]bool, autoTypes map[string]string) []client.CollectionField {
+	var patterns []*regexp.Regexp
+	for name := range autoTypes {
+		if !strings.ContainsAny(name, `.*+?[]()|^$\`) {
+			continue
+		}
+		if re, err := regexp.Compile("^(?:" + name + ")$"); err == nil {
+			patterns = append(patterns, re)
+		}
+	}
+	if len(patterns) == 0 {
+		return fields
+	}
+
+	result := make([]client.CollectionField, 0, len(fields))
+	for _, f := range fields {
+		matched := false
+		for _, re := range patterns {
+			if re.MatchString(f.Name) {
+				matched = true
+				break
+			}
+		}
+		if matched && !declared[f.Name] {
+			continue
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
 // buildIdFieldObject creates an object value for the implicit 'id' field
 func (r *CollectionResource) buildIdFieldObject(ctx context.Context, ef CollectionFieldModel, fAttrTypes map[string]attr.Type) attr.Value {
 	localeVal := types.StringNull()
@@ -912,6 +1462,11 @@ func (r *CollectionResource) apiFieldToObjectValue(ctx context.Context, f client
 		sortVal = types.BoolValue(*f.Sort)
 	}
 
+	optionalVal := types.BoolValue(false)
+	if f.Optional != nil {
+		optionalVal = types.BoolValue(*f.Optional)
+	}
+
 	localeVal := types.StringNull()
 	if f.Locale != "" {
 		localeVal = types.StringValue(f.Locale)
@@ -919,8 +1474,8 @@ func (r *CollectionResource) apiFieldToObjectValue(ctx context.Context, f client
 
 	// num_dim
 	numDimVal := types.Int64Null()
-	if f.NumDim > 0 {
-		numDimVal = types.Int64Value(f.NumDim)
+	if f.NumDim != nil {
+		numDimVal = types.Int64Value(*f.NumDim)
 	}
 
 	// vec_dist
@@ -946,11 +1501,21 @@ func (r *CollectionResource) apiFieldToObjectValue(ctx context.Context, f client
 		if f.Embed.ModelConfig.URL != "" {
 			urlVal = types.StringValue(f.Embed.ModelConfig.URL)
 		}
+		indexingPrefixVal := types.StringNull()
+		if f.Embed.ModelConfig.IndexingPrefix != "" {
+			indexingPrefixVal = types.StringValue(f.Embed.ModelConfig.IndexingPrefix)
+		}
+		queryPrefixVal := types.StringNull()
+		if f.Embed.ModelConfig.QueryPrefix != "" {
+			queryPrefixVal = types.StringValue(f.Embed.ModelConfig.QueryPrefix)
+		}
 
 		mcObj, _ := types.ObjectValue(embedModelConfigAttrTypes, map[string]attr.Value{
-			"model_name": types.StringValue(f.Embed.ModelConfig.ModelName),
-			"api_key":    apiKeyVal,
-			"url":        urlVal,
+			"model_name":      types.StringValue(f.Embed.ModelConfig.ModelName),
+			"api_key":         apiKeyVal,
+			"url":             urlVal,
+			"indexing_prefix": indexingPrefixVal,
+			"query_prefix":    queryPrefixVal,
 		})
 
 		embedVal, _ = types.ObjectValue(embedAttrTypes, map[string]attr.Value{
@@ -1022,7 +1587,7 @@ func (r *CollectionResource) apiFieldToObjectValue(ctx context.Context, f client
 		"name":             types.StringValue(f.Name),
 		"type":             types.StringValue(f.Type),
 		"facet":            types.BoolValue(f.Facet),
-		"optional":         types.BoolValue(f.Optional),
+		"optional":         optionalVal,
 		"index":            indexVal,
 		"sort":             sortVal,
 		"infix":            types.BoolValue(f.Infix),
@@ -1041,3 +1606,87 @@ func (r *CollectionResource) apiFieldToObjectValue(ctx context.Context, f client
 	})
 	return fieldObj
 }
+
+// metadataMatches reports whether the metadata attribute holds the same JSON
+// object as metadata, regardless of formatting and key order.
+func metadataMatches(v types.String, metadata map[string]any) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+	var decoded client.JSONObject
+	if err := json.Unmarshal([]byte(v.ValueString()), &decoded); err != nil {
+		return false
+	}
+	return jsonObjectsEqual(decoded, metadata)
+}
+
+// metadataChanged reports whether the planned metadata differs from the state
+// as JSON. Setting, changing and removing metadata are all changes.
+func metadataChanged(plan, state types.String) bool {
+	if plan.IsUnknown() {
+		return true
+	}
+	if plan.IsNull() || state.IsNull() {
+		return plan.IsNull() != state.IsNull()
+	}
+	var metadata client.JSONObject
+	if err := json.Unmarshal([]byte(state.ValueString()), &metadata); err != nil {
+		return plan.ValueString() != state.ValueString()
+	}
+	return !metadataMatches(plan, metadata)
+}
+
+// jsonObjectsEqual reports whether two decoded JSON objects hold the same
+// values. Numbers are compared by value, so 3 and 3.0 match while integers
+// too large for a float64 are still compared exactly.
+func jsonObjectsEqual(a, b map[string]any) bool {
+	return jsonValuesEqual(a, b)
+}
+
+func jsonValuesEqual(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !jsonValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		b, ok := b.(json.Number)
+		return ok && jsonNumbersEqual(a, b)
+	default:
+		// Strings, booleans and null.
+		return a == b
+	}
+}
+
+func jsonNumbersEqual(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	if ai, err := a.Int64(); err == nil {
+		if bi, err := b.Int64(); err == nil {
+			return ai == bi
+		}
+	}
+	af, errA := a.Float64()
+	bf, errB := b.Float64()
+	return errA == nil && errB == nil && af == bf
+}
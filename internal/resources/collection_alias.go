@@ -26,6 +26,7 @@ func NewCollectionAliasResource() resource.Resource {
 // CollectionAliasResource defines the resource implementation.
 type CollectionAliasResource struct {
 	client *client.ServerClient
+	prefix collectionNamePrefix
 }
 
 // CollectionAliasResourceModel describes the resource data model.
@@ -44,21 +45,21 @@ func (r *CollectionAliasResource) Schema(ctx context.Context, req resource.Schem
 		Description: "Manages a Typesense collection alias. Aliases allow you to refer to a collection by a virtual name, enabling zero-downtime reindexing and blue-green deployments.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier for the alias (same as name).",
+				Description: "Unique identifier for the alias: its name on the server, including any collection_name_prefix.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the alias. This is what you use in API calls instead of the actual collection name.",
+				Description: "The name of the alias. This is what you use in API calls instead of the actual collection name. The provider's collection_name_prefix is added on the server.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"collection_name": schema.StringAttribute{
-				Description: "The name of the collection this alias points to.",
+				Description: "The name of the collection this alias points to, without the provider's collection_name_prefix.",
 				Required:    true,
 			},
 		},
@@ -89,6 +90,7 @@ func (r *CollectionAliasResource) Configure(ctx context.Context, req resource.Co
 	}
 
 	r.client = providerData.ServerClient
+	r.prefix = collectionNamePrefix(providerData.CollectionNamePrefix)
 }
 
 func (r *CollectionAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -101,8 +103,8 @@ func (r *CollectionAliasResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	alias := &client.CollectionAlias{
-		Name:           data.Name.ValueString(),
-		CollectionName: data.CollectionName.ValueString(),
+		Name:           r.prefix.serverName(data.Name.ValueString()),
+		CollectionName: r.prefix.serverName(data.CollectionName.ValueString()),
 	}
 
 	created, err := r.client.UpsertCollectionAlias(ctx, alias)
@@ -125,7 +127,7 @@ func (r *CollectionAliasResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	alias, err := r.client.GetCollectionAlias(ctx, data.Name.ValueString())
+	alias, err := r.client.GetCollectionAlias(ctx, r.prefix.serverName(data.Name.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read collection alias: %s", err))
 		return
@@ -136,7 +138,7 @@ func (r *CollectionAliasResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	data.CollectionName = types.StringValue(alias.CollectionName)
+	data.CollectionName = types.StringValue(r.prefix.configName(alias.CollectionName))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -151,8 +153,8 @@ func (r *CollectionAliasResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	alias := &client.CollectionAlias{
-		Name:           data.Name.ValueString(),
-		CollectionName: data.CollectionName.ValueString(),
+		Name:           r.prefix.serverName(data.Name.ValueString()),
+		CollectionName: r.prefix.serverName(data.CollectionName.ValueString()),
 	}
 
 	_, err := r.client.UpsertCollectionAlias(ctx, alias)
@@ -173,7 +175,7 @@ func (r *CollectionAliasResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.client.DeleteCollectionAlias(ctx, data.Name.ValueString())
+	err := r.client.DeleteCollectionAlias(ctx, r.prefix.serverName(data.Name.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete collection alias: %s", err))
 		return
@@ -181,6 +183,9 @@ func (r *CollectionAliasResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *CollectionAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	// The import ID may be the alias name with or without the provider's
+	// collection_name_prefix.
+	name := r.prefix.configName(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.prefix.serverName(name))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/alanm/terraform-provider-typesense/internal/provider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 // =============================================================================
@@ -242,6 +243,146 @@ resource "typesense_collection" "test" {
 	})
 }
 
+// TestAccCollectionResource_facetToggleKeepsNumericSort tests that enabling
+// facet on a numeric field, which drops and re-adds the field, keeps the
+// server-default sort = true instead of producing an inconsistent result.
+func TestAccCollectionResource_facetToggleKeepsNumericSort(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-facet-toggle")
+
+	config := func(facet bool) string {
+		return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name  = "price"
+    type  = "int32"
+    facet = %[2]t
+  }
+}
+`, rName, facet)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.facet", "false"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.sort", "true"),
+				),
+			},
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.name", "price"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.facet", "true"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.sort", "true"),
+				),
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.facet", "false"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.sort", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccCollectionResource_fieldOrder tests that fields are matched by name:
+// the implicit id field keeps its declared position, a field the server moves
+// to the end when it is dropped and re-added stays where it is declared, and
+// reordering the field blocks leaves nothing to change after one apply.
+func TestAccCollectionResource_fieldOrder(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-field-order")
+
+	config := func(titleFacet bool, order ...string) string {
+		blocks := map[string]string{
+			"id": `
+  field {
+    name = "id"
+    type = "string"
+  }
+`,
+			"title": fmt.Sprintf(`
+  field {
+    name  = "title"
+    type  = "string"
+    facet = %t
+  }
+`, titleFacet),
+			"rating": `
+  field {
+    name = "rating"
+    type = "int32"
+  }
+`,
+		}
+
+		hcl := fmt.Sprintf("resource \"typesense_collection\" \"test\" {\n  name = %q\n", rName)
+		for _, name := range order {
+			hcl += blocks[name]
+		}
+		return hcl + "}\n"
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false, "title", "id", "rating"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.0.name", "title"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.name", "id"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.2.name", "rating"),
+				),
+			},
+			{
+				// Dropping and re-adding title moves it to the end of the
+				// server schema.
+				Config: config(true, "title", "id", "rating"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.0.name", "title"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.0.facet", "true"),
+				),
+			},
+			{
+				Config: config(true, "title", "id", "rating"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+			{
+				Config: config(true, "rating", "title", "id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.0.name", "rating"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.name", "title"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.2.name", "id"),
+				),
+			},
+			{
+				Config: config(true, "rating", "title", "id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+		},
+	})
+}
+
 // TestAccCollectionResource_allFieldAttributesUnset tests a field with all
 // optional attributes unset. This catches any server-side default mismatches.
 func TestAccCollectionResource_allFieldAttributesUnset(t *testing.T) {
@@ -481,3 +622,155 @@ resource "typesense_collection" "test" {
 		},
 	})
 }
+
+// TestAccCollectionResource_serverOptionalField tests a field the server makes
+// optional on its own: an unindexed field is always optional, so leaving
+// optional unset must store the server's true rather than assume false.
+func TestAccCollectionResource_serverOptionalField(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-server-optional")
+	config := fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name  = "raw_payload"
+    type  = "string"
+    index = false
+  }
+}
+`, rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.0.optional", "false"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.optional", "true"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// TestAccCollectionResource_cjkLocaleField tests that fields with a CJK
+// locale round-trip, including one written in upper case, and that a second
+// plan is empty.
+func TestAccCollectionResource_cjkLocaleField(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-cjk-locale")
+	config := fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name   = "title_ja"
+    type   = "string"
+    locale = "ja"
+  }
+
+  field {
+    name   = "title_zh"
+    type   = "string"
+    locale = "ZH"
+  }
+}
+`, rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.0.locale", "ja"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.locale", "ZH"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// =============================================================================
+// VECTOR FIELD TESTS
+// =============================================================================
+
+// TestAccCollectionResource_vectorFieldConsistency tests that a vector field
+// round-trips: the server echoes num_dim and vec_dist, and a second plan,
+// including one with vec_dist left to its default, is empty.
+func TestAccCollectionResource_vectorFieldConsistency(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-vector-consistency")
+
+	config := func(vecDist string) string {
+		attr := ""
+		if vecDist != "" {
+			attr = fmt.Sprintf("vec_dist = %q", vecDist)
+		}
+		return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name    = "embedding"
+    type    = "float[]"
+    num_dim = 768
+    %[2]s
+  }
+}
+`, rName, attr)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("cosine"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.num_dim", "768"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.vec_dist", "cosine"),
+				),
+			},
+			{
+				Config: config("cosine"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+			{
+				Config: config(""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+			},
+		},
+	})
+}
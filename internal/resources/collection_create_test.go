@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCollectionCreateReadsBackIncompleteResponse(t *testing.T) {
+	tests := []struct {
+		name         string
+		postResponse string
+		wantGet      bool
+	}{
+		{
+			name:         "response without created_at",
+			postResponse: `{"name": "products", "fields": [{"name": "title", "type": "string"}]}`,
+			wantGet:      true,
+		},
+		{
+			name:         "complete response",
+			postResponse: `{"name": "products", "fields": [{"name": "title", "type": "string"}], "created_at": 1700000000, "num_documents": 0}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gets int
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/collections":
+					w.WriteHeader(http.StatusCreated)
+					_, _ = w.Write([]byte(tt.postResponse))
+				case r.Method == http.MethodGet && r.URL.Path == "/collections/products":
+					gets++
+					_, _ = w.Write([]byte(`{"name": "products", "fields": [{"name": "title", "type": "string"}], "created_at": 1700000000, "num_documents": 0}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			ctx := context.Background()
+			r := &CollectionResource{client: newTestServerClient(t, handler)}
+			plan := collectionPlanWithFields(t, r, []string{"title"})
+
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+			}
+
+			if got := gets > 0; got != tt.wantGet {
+				t.Errorf("collection read back = %v, want %v", got, tt.wantGet)
+			}
+
+			var createdAt, numDocuments types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("created_at"), &createdAt)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("num_documents"), &numDocuments)...)
+			if createdAt.IsUnknown() || createdAt.ValueInt64() != 1700000000 {
+				t.Errorf("created_at = %s, want 1700000000", createdAt)
+			}
+			if numDocuments.IsUnknown() || numDocuments.IsNull() || numDocuments.ValueInt64() != 0 {
+				t.Errorf("num_documents = %s, want 0", numDocuments)
+			}
+		})
+	}
+}
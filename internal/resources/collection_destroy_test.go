@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// deleteCollectionWith runs Delete on a "products" collection against handler
+// and returns the response.
+func deleteCollectionWith(t *testing.T, handler http.Handler, truncate bool) resource.DeleteResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler)}
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+	state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+	if diags := state.SetAttribute(ctx, path.Root("truncate_on_destroy"), truncate); diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	return resp
+}
+
+func TestCollectionDeleteTruncateOnDestroy(t *testing.T) {
+	for _, truncate := range []bool{false, true} {
+		var requests []string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.RequestURI())
+			switch r.URL.Path {
+			case "/aliases":
+				_, _ = w.Write([]byte(`{"aliases": [{"name": "other", "collection_name": "orders"}]}`))
+			case "/collections/products/documents":
+				_, _ = w.Write([]byte(`{"num_deleted": 3}`))
+			case "/collections/products":
+				_, _ = w.Write([]byte(`{"name": "products"}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		resp := deleteCollectionWith(t, handler, truncate)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Delete returned errors: %v", resp.Diagnostics)
+		}
+
+		want := []string{"DELETE /collections/products"}
+		if truncate {
+			want = []string{"GET /aliases", "DELETE /collections/products/documents?truncate=true", "DELETE /collections/products"}
+		}
+		if !slices.Equal(requests, want) {
+			t.Errorf("truncate_on_destroy = %v: requests = %v, want %v", truncate, requests, want)
+		}
+	}
+}
+
+func TestCollectionDeleteTruncateOnDestroyStopsAtAlias(t *testing.T) {
+	var requests []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.URL.Path == "/aliases" {
+			_, _ = w.Write([]byte(`{"aliases": [{"name": "live", "collection_name": "products"}]}`))
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	resp := deleteCollectionWith(t, handler, true)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a collection that is the target of an alias")
+	}
+	if want := []string{"GET /aliases"}; !slices.Equal(requests, want) {
+		t.Errorf("requests = %v, want %v: documents must not be deleted behind an alias", requests, want)
+	}
+}
+
+func TestCollectionDeleteTruncateOnDestroyOfMissingCollection(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/aliases" {
+			_, _ = w.Write([]byte(`{"aliases": []}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+
+	resp := deleteCollectionWith(t, handler, true)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Delete of a collection that is already gone returned errors: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,201 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func embeddingField(modelName string) client.CollectionField {
+	return client.CollectionField{
+		Name: "embedding",
+		Type: "float[]",
+		Embed: &client.FieldEmbed{
+			From:        []string{"title"},
+			ModelConfig: client.FieldModelConfig{ModelName: modelName},
+		},
+	}
+}
+
+func TestCollectionFieldChangesReembedsChangedModel(t *testing.T) {
+	current := []client.CollectionField{{Name: "title", Type: "string"}, embeddingField("ts/all-MiniLM-L12-v2")}
+	planned := []client.CollectionField{{Name: "title", Type: "string"}, embeddingField("ts/e5-small")}
+
+	if got := embedChangedFields(current, planned); len(got) != 1 || got[0] != "embedding" {
+		t.Fatalf("embedChangedFields = %v, want [embedding]", got)
+	}
+
+	changes := collectionFieldChanges(current, planned)
+	if len(changes) != 2 || !changes[0].Drop || changes[1].Embed == nil || changes[1].Embed.ModelConfig.ModelName != "ts/e5-small" {
+		t.Fatalf("collectionFieldChanges = %+v, want embedding dropped and re-added with the new model", changes)
+	}
+
+	// Rotating the api_key does not change the vectors.
+	rotated := embeddingField("ts/all-MiniLM-L12-v2")
+	rotated.Embed.ModelConfig.APIKey = "new-key"
+	if embedChanged(current[1].Embed, rotated.Embed) {
+		t.Error("embedChanged reported a change for a new api_key")
+	}
+}
+
+func TestCollectionEmbedPrefixesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	field := embeddingField("ts/e5-small")
+	field.Embed.ModelConfig.IndexingPrefix = "passage:"
+	field.Embed.ModelConfig.QueryPrefix = "query:"
+
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+	fieldType := types.ObjectType{AttrTypes: fieldAttrTypes()}
+	fields := []attr.Value{
+		r.apiFieldToObjectValue(ctx, client.CollectionField{Name: "title", Type: "string"}, fieldAttrTypes()),
+		r.apiFieldToObjectValue(ctx, field, fieldAttrTypes()),
+	}
+	if diags := plan.SetAttribute(ctx, path.Root("field"), types.ListValueMust(fieldType, fields)); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+	var data CollectionResourceModel
+	if diags := plan.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to read plan: %v", diags)
+	}
+
+	collection, diags := r.modelToCollection(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("modelToCollection returned errors: %v", diags)
+	}
+	if got := collection.Fields[1].Embed.ModelConfig; got.IndexingPrefix != "passage:" || got.QueryPrefix != "query:" {
+		t.Errorf("model_config = %+v, want the indexing and query prefixes", got)
+	}
+
+	// A changed prefix changes the text sent to the model.
+	changed := embeddingField("ts/e5-small")
+	changed.Embed.ModelConfig.IndexingPrefix = "passage: "
+	changed.Embed.ModelConfig.QueryPrefix = "query:"
+	if !embedChanged(field.Embed, changed.Embed) {
+		t.Error("embedChanged did not report a changed indexing_prefix")
+	}
+}
+
+func TestCollectionFieldChangesUpdatesQueryPrefixInPlace(t *testing.T) {
+	current := []client.CollectionField{embeddingField("ts/e5-small")}
+	current[0].Embed.ModelConfig.QueryPrefix = "query:"
+	planned := []client.CollectionField{embeddingField("ts/e5-small")}
+	planned[0].Embed.ModelConfig.QueryPrefix = "search_query:"
+
+	// Stored vectors do not depend on the query prefix.
+	if got := embedChangedFields(current, planned); len(got) != 0 {
+		t.Errorf("embedChangedFields = %v, want no re-embedding for a new query_prefix", got)
+	}
+
+	changes := collectionFieldChanges(current, planned)
+	if len(changes) != 1 || changes[0].Drop || changes[0].Embed.ModelConfig.QueryPrefix != "search_query:" {
+		t.Errorf("collectionFieldChanges = %+v, want the field sent once, without a drop, with the new query_prefix", changes)
+	}
+}
+
+// collectionPlanWithEmbedding builds a "products" plan with a title field and
+// an embedding field generated from it by modelName.
+func collectionPlanWithEmbedding(t *testing.T, r *CollectionResource, modelName string, reindex bool) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+
+	fieldType := types.ObjectType{AttrTypes: fieldAttrTypes()}
+	fields := []attr.Value{
+		r.apiFieldToObjectValue(ctx, client.CollectionField{Name: "title", Type: "string"}, fieldAttrTypes()),
+		r.apiFieldToObjectValue(ctx, embeddingField(modelName), fieldAttrTypes()),
+	}
+	diags := plan.SetAttribute(ctx, path.Root("field"), types.ListValueMust(fieldType, fields))
+	diags.Append(plan.SetAttribute(ctx, path.Root("reindex_on_embed_change"), reindex)...)
+	if diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+	return plan
+}
+
+func TestCollectionModifyPlanEmbedChange(t *testing.T) {
+	tests := []struct {
+		name        string
+		reindex     bool
+		wantError   bool
+		wantWarning bool
+	}{
+		{name: "without reindex_on_embed_change", wantError: true},
+		{name: "with reindex_on_embed_change", reindex: true, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			current := collectionPlanWithEmbedding(t, r, "ts/all-MiniLM-L12-v2", tt.reindex)
+			state := tfsdk.State{Schema: current.Schema, Raw: current.Raw}
+			plan := collectionPlanWithEmbedding(t, r, "ts/e5-small", tt.reindex)
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("errors = %v, want error %v", resp.Diagnostics, tt.wantError)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("warnings = %v, want warning %v", resp.Diagnostics, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestUpdateModelFromCollectionVectorFields(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	plan := collectionPlanWithEmbedding(t, r, "ts/all-MiniLM-L12-v2", false)
+	var data CollectionResourceModel
+	if diags := plan.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to read plan: %v", diags)
+	}
+
+	// The server reports the model's dimensions and the default distance.
+	served := embeddingField("ts/all-MiniLM-L12-v2")
+	numDim := int64(384)
+	served.NumDim = &numDim
+	served.VecDist = "cosine"
+	r.updateModelFromCollection(ctx, &data, &client.Collection{
+		Name:   "products",
+		Fields: []client.CollectionField{{Name: "title", Type: "string"}, served},
+	})
+
+	var fields []CollectionFieldModel
+	if diags := data.Fields.ElementsAs(ctx, &fields, false); diags.HasError() {
+		t.Fatalf("failed to read fields: %v", diags)
+	}
+	if got := fields[1]; !got.NumDim.IsNull() || got.VecDist.ValueString() != "cosine" {
+		t.Errorf("embedding num_dim = %s, vec_dist = %s, want null and cosine", got.NumDim, got.VecDist)
+	}
+}
+
+func TestCollectionFieldChangedVectorDefaults(t *testing.T) {
+	dims768, dims1536, dims384 := int64(768), int64(1536), int64(384)
+	current := client.CollectionField{Name: "vec", Type: "float[]", NumDim: &dims768, VecDist: "cosine"}
+
+	if collectionFieldChanged(current, client.CollectionField{Name: "vec", Type: "float[]", NumDim: &dims768}) {
+		t.Error("an unset vec_dist was reported as a change")
+	}
+	if !collectionFieldChanged(current, client.CollectionField{Name: "vec", Type: "float[]", NumDim: &dims768, VecDist: "ip"}) {
+		t.Error("a changed vec_dist was not reported")
+	}
+	if !collectionFieldChanged(current, client.CollectionField{Name: "vec", Type: "float[]", NumDim: &dims1536}) {
+		t.Error("a changed num_dim was not reported")
+	}
+
+	served := embeddingField("ts/e5-small")
+	served.NumDim = &dims384
+	if collectionFieldChanged(served, embeddingField("ts/e5-small")) {
+		t.Error("the model-derived num_dim of an embed field was reported as a change")
+	}
+}
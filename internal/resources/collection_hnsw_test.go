@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCollectionHnswParamsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	numDim := int64(4)
+	vector := client.CollectionField{
+		Name:       "embedding",
+		Type:       "float[]",
+		NumDim:     &numDim,
+		HnswParams: &client.FieldHnswParams{EfConstruction: 400, M: 32},
+	}
+
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+	fieldType := types.ObjectType{AttrTypes: fieldAttrTypes()}
+	fields := []attr.Value{
+		r.apiFieldToObjectValue(ctx, client.CollectionField{Name: "title", Type: "string"}, fieldAttrTypes()),
+		r.apiFieldToObjectValue(ctx, vector, fieldAttrTypes()),
+	}
+	if diags := plan.SetAttribute(ctx, path.Root("field"), types.ListValueMust(fieldType, fields)); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+	var data CollectionResourceModel
+	if diags := plan.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to read plan: %v", diags)
+	}
+
+	collection, diags := r.modelToCollection(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("modelToCollection returned errors: %v", diags)
+	}
+	body, err := json.Marshal(collection.Fields[1])
+	if err != nil {
+		t.Fatalf("failed to encode field: %v", err)
+	}
+	if !strings.Contains(string(body), `"hnsw_params":{"ef_construction":400,"M":32}`) {
+		t.Fatalf("field JSON = %s, want hnsw_params with ef_construction 400 and M 32", body)
+	}
+
+	// The server echoes the parameters back in the same shape.
+	var stored client.CollectionField
+	if err := json.Unmarshal(body, &stored); err != nil {
+		t.Fatalf("failed to decode field: %v", err)
+	}
+	hnsw := r.apiFieldToObjectValue(ctx, stored, fieldAttrTypes()).(types.Object).Attributes()["hnsw_params"].(types.Object).Attributes()
+	if got := hnsw["ef_construction"].(types.Int64).ValueInt64(); got != 400 {
+		t.Errorf("ef_construction = %d, want 400", got)
+	}
+	if got := hnsw["m"].(types.Int64).ValueInt64(); got != 32 {
+		t.Errorf("m = %d, want 32", got)
+	}
+}
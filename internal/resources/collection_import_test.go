@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeclaredCollectionFieldsDropsServerFlattenedFields(t *testing.T) {
+	serverFields := []client.CollectionField{
+		{Name: "title", Type: "string"},
+		{Name: "person", Type: "object"},
+		{Name: "person.name", Type: "string", Nested: true},
+		{Name: "person.age", Type: "int64", Nested: true},
+		{Name: "tags.label", Type: "string[]", Nested: true},
+		{Name: "items", Type: "object[]"},
+		{Name: "items.sku", Type: "string[]"},
+	}
+
+	tests := []struct {
+		name     string
+		declared map[string]bool
+		want     []string
+	}{
+		{
+			name:     "import keeps only top-level fields",
+			declared: map[string]bool{},
+			want:     []string{"title", "person", "items"},
+		},
+		{
+			name:     "explicitly declared nested field is kept",
+			declared: map[string]bool{"title": true, "person": true, "person.age": true, "items": true},
+			want:     []string{"title", "person", "person.age", "items"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := declaredCollectionFields(serverFields, tt.declared)
+
+			var names []string
+			for _, f := range got {
+				names = append(names, f.Name)
+			}
+			if len(names) != len(tt.want) {
+				t.Fatalf("fields = %v, want %v", names, tt.want)
+			}
+			for i := range names {
+				if names[i] != tt.want[i] {
+					t.Fatalf("fields = %v, want %v", names, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateModelFromCollectionKeepsEquivalentReference(t *testing.T) {
+	tests := []struct {
+		name      string
+		declared  string
+		server    string
+		wantState string
+	}{
+		{name: "server adds the id field", declared: "authors", server: "authors.id", wantState: "authors"},
+		{name: "server drops the id field", declared: "authors.id", server: "authors", wantState: "authors.id"},
+		{name: "changed reference is refreshed", declared: "authors.id", server: "publishers.id", wantState: "publishers.id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			plan := collectionPlanWithFields(t, r, []string{"author_id"})
+			if diags := plan.SetAttribute(ctx, path.Root("field").AtListIndex(0).AtName("reference"), tt.declared); diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+			var data CollectionResourceModel
+			if diags := plan.Get(ctx, &data); diags.HasError() {
+				t.Fatalf("failed to read plan: %v", diags)
+			}
+
+			r.updateModelFromCollection(ctx, &data, &client.Collection{
+				Name:   "books",
+				Fields: []client.CollectionField{{Name: "author_id", Type: "string", Reference: tt.server}},
+			})
+
+			var fields []CollectionFieldModel
+			data.Fields.ElementsAs(ctx, &fields, false)
+			if len(fields) != 1 || fields[0].Reference.ValueString() != tt.wantState {
+				t.Fatalf("reference = %v, want %s", fields, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestFieldReferenceRequiresReplace(t *testing.T) {
+	tests := []struct {
+		state, plan string
+		want        bool
+	}{
+		{state: "authors.id", plan: "authors", want: false},
+		{state: "authors", plan: "authors.id", want: false},
+		{state: "authors.id", plan: "authors.name", want: true},
+		{state: "authors.id", plan: "publishers", want: true},
+	}
+
+	ctx := context.Background()
+	plan := collectionPlanWithFields(t, &CollectionResource{}, nil)
+	for _, tt := range tests {
+		req := planmodifier.StringRequest{
+			State:      tfsdk.State{Schema: plan.Schema, Raw: plan.Raw},
+			Plan:       plan,
+			StateValue: types.StringValue(tt.state),
+			PlanValue:  types.StringValue(tt.plan),
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		fieldReferenceRequiresReplace().PlanModifyString(ctx, req, resp)
+		if resp.RequiresReplace != tt.want {
+			t.Errorf("%s -> %s: RequiresReplace = %v, want %v", tt.state, tt.plan, resp.RequiresReplace, tt.want)
+		}
+	}
+}
+
+func TestCollectionFieldChangesIgnoresEquivalentReference(t *testing.T) {
+	current := []client.CollectionField{{Name: "author_id", Type: "string", Reference: "authors.id"}}
+	planned := []client.CollectionField{{Name: "author_id", Type: "string", Reference: "authors"}}
+
+	if changes := collectionFieldChanges(current, planned); len(changes) != 0 {
+		t.Fatalf("changes = %+v, want none for the same reference", changes)
+	}
+}
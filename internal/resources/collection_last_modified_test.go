@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const priorLastModifiedAt = "2025-01-01T00:00:00Z"
+
+// collectionStateWithLastModified builds a collection state with a string
+// field per name and last_modified_at set to priorLastModifiedAt.
+func collectionStateWithLastModified(t *testing.T, r *CollectionResource, names []string) tfsdk.State {
+	t.Helper()
+	plan := collectionPlanWithFields(t, r, names)
+	state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+	if diags := state.SetAttribute(context.Background(), path.Root("last_modified_at"), priorLastModifiedAt); diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+	return state
+}
+
+func TestCollectionModifyPlanLastModifiedAt(t *testing.T) {
+	tests := []struct {
+		name        string
+		planned     []string
+		forceChange bool
+		wantUnknown bool
+	}{
+		{name: "added field", planned: []string{"title", "description"}, wantUnknown: true},
+		{name: "unchanged", planned: []string{"title"}},
+		{name: "change without an alter", planned: []string{"title"}, forceChange: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			state := collectionStateWithLastModified(t, r, []string{"title"})
+			plan := collectionPlanWithFields(t, r, tt.planned)
+			diags := plan.SetAttribute(ctx, path.Root("last_modified_at"), priorLastModifiedAt)
+			if tt.forceChange {
+				diags.Append(plan.SetAttribute(ctx, path.Root("force_destroy"), true)...)
+			}
+			if diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+
+			var lastModified types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("last_modified_at"), &lastModified)...)
+			if lastModified.IsUnknown() != tt.wantUnknown {
+				t.Fatalf("last_modified_at unknown = %v, want %v", lastModified.IsUnknown(), tt.wantUnknown)
+			}
+			if !tt.wantUnknown && lastModified.ValueString() != priorLastModifiedAt {
+				t.Errorf("last_modified_at = %s, want the prior value kept", lastModified)
+			}
+		})
+	}
+}
+
+func TestCollectionUpdateRecordsLastModifiedAt(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			_, _ = w.Write([]byte(`{"fields": [{"name": "description", "type": "string"}]}`))
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"name": "products", "fields": [
+				{"name": "title", "type": "string"},
+				{"name": "description", "type": "string"}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler)}
+	state := collectionStateWithLastModified(t, r, []string{"title"})
+	plan := collectionPlanWithFields(t, r, []string{"title", "description"})
+	if diags := plan.SetAttribute(ctx, path.Root("last_modified_at"), types.StringUnknown()); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	before := time.Now().UTC().Truncate(time.Second)
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", resp.Diagnostics)
+	}
+
+	var lastModified types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("last_modified_at"), &lastModified)...)
+	got, err := time.Parse(time.RFC3339, lastModified.ValueString())
+	if err != nil {
+		t.Fatalf("last_modified_at = %s, want an RFC 3339 time: %v", lastModified, err)
+	}
+	if got.Before(before) {
+		t.Errorf("last_modified_at = %s, want the time of this update (after %s)", got, before)
+	}
+}
@@ -0,0 +1,194 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// collectionPlanWithMetadata builds a "products" collection plan with a title
+// field and the given metadata; an empty string leaves it null.
+func collectionPlanWithMetadata(t *testing.T, r *CollectionResource, metadata string) tfsdk.Plan {
+	t.Helper()
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+	value := types.StringNull()
+	if metadata != "" {
+		value = types.StringValue(metadata)
+	}
+	if diags := plan.SetAttribute(context.Background(), path.Root("metadata"), value); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+	return plan
+}
+
+func TestCollectionUpdateMetadata(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     string
+		planned   string
+		wantPatch string
+	}{
+		{name: "set", planned: `{"team": "search"}`, wantPatch: `{"metadata":{"team":"search"}}`},
+		{name: "change", state: `{"team": "search"}`, planned: `{"team": "catalog"}`, wantPatch: `{"metadata":{"team":"catalog"}}`},
+		{name: "clear", state: `{"team": "search"}`, wantPatch: `{"metadata":{}}`},
+		{name: "reformatted", state: `{"team":"search","tier":1}`, planned: "{\n  \"tier\": 1,\n  \"team\": \"search\"\n}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch string
+			serverMetadata := `{}`
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					body, _ := io.ReadAll(r.Body)
+					patch = string(body)
+					serverMetadata = patch[len(`{"metadata":`) : len(patch)-1]
+					_, _ = w.Write(body)
+				case http.MethodGet:
+					if patch == "" && tt.state != "" {
+						serverMetadata = tt.state
+					}
+					_, _ = w.Write([]byte(`{"name": "products", "fields": [{"name": "title", "type": "string"}], "metadata": ` + serverMetadata + `}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			ctx := context.Background()
+			r := &CollectionResource{client: newTestServerClient(t, handler)}
+			prior := collectionPlanWithMetadata(t, r, tt.state)
+			state := tfsdk.State{Schema: prior.Schema, Raw: prior.Raw}
+			plan := collectionPlanWithMetadata(t, r, tt.planned)
+
+			resp := resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update returned errors: %v", resp.Diagnostics)
+			}
+
+			if patch != tt.wantPatch {
+				t.Errorf("PATCH body = %q, want %q", patch, tt.wantPatch)
+			}
+
+			var metadata types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("metadata"), &metadata)...)
+			if tt.planned == "" && !metadata.IsNull() {
+				t.Errorf("metadata = %s, want null", metadata)
+			}
+			if tt.planned != "" && metadata.ValueString() != tt.planned {
+				t.Errorf("metadata = %s, want the planned %s", metadata, tt.planned)
+			}
+		})
+	}
+}
+
+func TestUpdateModelFromCollectionMetadataDrift(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    string
+		server   map[string]any
+		want     string
+		wantNull bool
+	}{
+		{name: "unchanged keeps the configured formatting", state: `{ "team": "search" }`, server: map[string]any{"team": "search"}, want: `{ "team": "search" }`},
+		{name: "changed outside Terraform", state: `{"team": "search"}`, server: map[string]any{"team": "catalog"}, want: `{"team":"catalog"}`},
+		{name: "added outside Terraform", server: map[string]any{"team": "search"}, want: `{"team":"search"}`},
+		{name: "cleared outside Terraform", state: `{"team": "search"}`, server: map[string]any{}, wantNull: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			plan := collectionPlanWithMetadata(t, r, tt.state)
+			var data CollectionResourceModel
+			if diags := plan.Get(ctx, &data); diags.HasError() {
+				t.Fatalf("failed to read plan: %v", diags)
+			}
+
+			r.updateModelFromCollection(ctx, &data, &client.Collection{
+				Name:     "products",
+				Fields:   []client.CollectionField{{Name: "title", Type: "string"}},
+				Metadata: tt.server,
+			})
+
+			if tt.wantNull {
+				if !data.Metadata.IsNull() {
+					t.Errorf("metadata = %s, want null", data.Metadata)
+				}
+				return
+			}
+			if data.Metadata.ValueString() != tt.want {
+				t.Errorf("metadata = %s, want %s", data.Metadata, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectionReadMetadataNumbers(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  string
+		server string
+		want   string
+	}{
+		{
+			name:   "integers and floats unchanged",
+			state:  `{"count": 3, "ratio": 0.5, "weight": 2}`,
+			server: `{"count": 3, "ratio": 0.5, "weight": 2.0}`,
+			want:   `{"count": 3, "ratio": 0.5, "weight": 2}`,
+		},
+		{
+			name:   "large integer unchanged",
+			state:  `{"seed": 9007199254740993}`,
+			server: `{"seed": 9007199254740993}`,
+			want:   `{"seed": 9007199254740993}`,
+		},
+		{
+			name:   "large integer changed outside Terraform",
+			state:  `{"seed": 9007199254740992}`,
+			server: `{"seed": 9007199254740993}`,
+			want:   `{"seed":9007199254740993}`,
+		},
+		{
+			name:   "changed outside Terraform keeps integer and float forms",
+			state:  `{"count": 3, "ratio": 0.5}`,
+			server: `{"count": 4, "ratio": 1.5}`,
+			want:   `{"count":4,"ratio":1.5}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"name": "products", "fields": [{"name": "title", "type": "string"}], "metadata": ` + tt.server + `}`))
+			})
+
+			ctx := context.Background()
+			r := &CollectionResource{client: newTestServerClient(t, handler)}
+			prior := collectionPlanWithMetadata(t, r, tt.state)
+			state := tfsdk.State{Schema: prior.Schema, Raw: prior.Raw}
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			var metadata types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("metadata"), &metadata)...)
+			if metadata.ValueString() != tt.want {
+				t.Errorf("metadata = %s, want %s", metadata, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithModifyPlan = &CollectionResource{}
+
+// ModifyPlan plans the id as the prefixed server name when the provider sets
+// collection_name_prefix, which the schema-level collectionIDFromName cannot
+// see, and leaves last_modified_at unknown when the update alters the
+// collection. It also warns when the schema_from collection does not exist
+// before a collection is created from it, and when an in-place update drops
+// some fields and adds others. That is how a renamed field is applied (one
+// PATCH adding the new name and dropping the old one), and Typesense does not
+// carry the stored values over. A changed embed is an error unless
+// reindex_on_embed_change is set, since otherwise the stored vectors would
+// silently stop matching the query model. synonym_sets and curation_sets are
+// rejected on servers known to predate v30. Field references to collections
+// or fields that do not exist yet produce a warning.
+func (r *CollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.prefix != "" && !plan.Name.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), r.prefix.serverName(plan.Name.ValueString()))...)
+	}
+
+	r.checkSetAssociationsSupported(ctx, resp)
+	r.warnMissingReferences(ctx, plan, req, resp)
+
+	if req.State.Raw.IsNull() {
+		r.warnMissingSchemaFrom(ctx, plan, resp)
+		return
+	}
+
+	var state CollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.planLastModifiedAt(ctx, plan, state, req, resp)
+
+	// Fields copied with schema_from are not managed after creation.
+	if !plan.SchemaFrom.IsNull() {
+		return
+	}
+
+	// A changed collection name is either a replacement or a reindex into a
+	// fresh collection; neither goes through the field PATCH.
+	if plan.Name.IsUnknown() || plan.Name.ValueString() != state.Name.ValueString() || plan.Fields.IsUnknown() {
+		return
+	}
+
+	plannedFields, diags := r.extractFields(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	currentFields, diags := r.extractFields(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if names := embedChangedFields(currentFields, plannedFields); len(names) > 0 && !plan.ReindexOnEmbedChange.IsUnknown() {
+		if !plan.ReindexOnEmbedChange.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("field"), "Embedding Change Requires Re-embedding", embedChangeDetail(names))
+			return
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("field"),
+			"Documents Will Be Re-embedded",
+			fmt.Sprintf("The embed configuration of field(s) %s changes, so the field is dropped and re-added and Typesense regenerates the embedding of every stored document. "+
+				"This calls the embedding model once per document and can take a long time on a large collection.", strings.Join(names, ", ")),
+		)
+	}
+
+	// A field both dropped and added under the same name is a changed
+	// definition that is reindexed in place, not a rename, and an existing
+	// field sent without a drop is updated in place.
+	changes := collectionFieldChanges(currentFields, plannedFields)
+	changeCount := make(map[string]int)
+	for _, f := range changes {
+		changeCount[f.Name]++
+	}
+	existing := make(map[string]bool, len(currentFields))
+	for _, f := range currentFields {
+		existing[f.Name] = true
+	}
+
+	var added, dropped []string
+	for _, f := range changes {
+		switch {
+		case changeCount[f.Name] > 1:
+		case !f.Drop && existing[f.Name]:
+		case f.Drop:
+			dropped = append(dropped, f.Name)
+		default:
+			added = append(added, f.Name)
+		}
+	}
+	if len(added) == 0 || len(dropped) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("field"),
+		"Field Data Will Not Be Copied",
+		fmt.Sprintf("This update drops field(s) %s and adds field(s) %s in a single schema update. "+
+			"If this renames a field, the values stored in the old field are NOT copied to the new one: "+
+			"existing documents lose them and the new field stays empty until documents are re-imported with values under the new name.",
+			strings.Join(dropped, ", "), strings.Join(added, ", ")),
+	)
+}
+
+// embedChangeDetail explains why an embed change is refused without
+// reindex_on_embed_change.
+func embedChangeDetail(names []string) string {
+	return fmt.Sprintf("The embed configuration of field(s) %s changes. Documents already in the collection keep the vectors generated by the old configuration, "+
+		"which no longer match the vectors the new model produces for queries. "+
+		"Set reindex_on_embed_change = true to drop and re-add the field so Typesense re-embeds every stored document, "+
+		"or recreate the collection and re-upsert the documents.", strings.Join(names, ", "))
+}
+
+// planLastModifiedAt leaves last_modified_at unknown when Update will alter
+// the collection, i.e. the plan changes something, keeps the name, and either
+// changes fields, metadata or the associated synonym and curation sets.
+// Otherwise the prior value is kept.
+func (r *CollectionResource) planLastModifiedAt(ctx context.Context, plan, state CollectionResourceModel, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	if plan.Name.IsUnknown() || plan.Name.ValueString() != state.Name.ValueString() {
+		return
+	}
+
+	alters := metadataChanged(plan.Metadata, state.Metadata) ||
+		!plan.SynonymSets.Equal(state.SynonymSets) || !plan.CurationSets.Equal(state.CurationSets)
+	if !alters && plan.SchemaFrom.IsNull() {
+		if plan.Fields.IsUnknown() {
+			alters = true
+		} else {
+			plannedFields, diags := r.extractFields(ctx, &plan)
+			resp.Diagnostics.Append(diags...)
+			currentFields, diags := r.extractFields(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			alters = len(collectionFieldChanges(currentFields, plannedFields)) > 0
+		}
+	}
+	if alters {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_modified_at"), types.StringUnknown())...)
+	}
+}
+
+// checkSetAssociationsSupported rejects synonym_sets and curation_sets when
+// the server is known to be older than v30, which has no such sets. When the
+// version could not be detected, or version checks are ignored, the server
+// decides.
+func (r *CollectionResource) checkSetAssociationsSupported(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	if r.featureChecker == nil || r.featureChecker.GetVersion() == nil || version.VersionChecksIgnored(r.featureChecker) ||
+		r.featureChecker.SupportsFeature(version.FeatureSynonymSets) {
+		return
+	}
+	for _, attribute := range []string{"synonym_sets", "curation_sets"} {
+		var value types.List
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if value.IsNull() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Unsupported Collection Attribute",
+			fmt.Sprintf("%s requires Typesense v30 or later; the server runs %s. Use typesense_synonym and typesense_override, which are attached to the collection directly, on earlier versions.", attribute, r.featureChecker.GetVersion()),
+		)
+	}
+}
+
+// warnMissingSchemaFrom warns at plan time when the schema_from collection
+// does not exist. It is only a warning because the source may be created
+// earlier in the same apply; Create fails if it is still missing then.
+func (r *CollectionResource) warnMissingSchemaFrom(ctx context.Context, plan CollectionResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || plan.SchemaFrom.IsNull() || plan.SchemaFrom.IsUnknown() {
+		return
+	}
+
+	source := plan.SchemaFrom.ValueString()
+	collection, err := r.client.GetCollection(ctx, r.prefix.serverName(source))
+	if err != nil || collection != nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("schema_from"),
+		"Source Collection Not Found",
+		fmt.Sprintf("schema_from refers to collection %q, which does not exist yet. Creating this collection will fail unless %q is created earlier in the same apply.", source, source),
+	)
+}
+
+// warnMissingReferences warns at plan time when a field's reference names a
+// collection, or a field of it, that does not exist on the server. Only
+// references that are new or changed are looked up. It is only a warning
+// because the referenced collection may be created earlier in the same apply;
+// lookup errors are ignored and left to Create.
+func (r *CollectionResource) warnMissingReferences(ctx context.Context, plan CollectionResourceModel, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !plan.SchemaFrom.IsNull() || plan.Fields.IsNull() || plan.Fields.IsUnknown() {
+		return
+	}
+
+	plannedFields, diags := r.extractFields(ctx, &plan)
+	if diags.HasError() {
+		return
+	}
+
+	existing := make(map[string]string)
+	if !req.State.Raw.IsNull() {
+		var state CollectionResourceModel
+		if diags := req.State.Get(ctx, &state); !diags.HasError() {
+			if currentFields, diags := r.extractFields(ctx, &state); !diags.HasError() {
+				for _, f := range currentFields {
+					existing[f.Name] = r.prefix.configReference(f.Reference)
+				}
+			}
+		}
+	}
+
+	collections := make(map[string]*client.Collection)
+	for i, f := range plannedFields {
+		// extractFields adds the prefix; names are compared and reported as
+		// written in configuration.
+		reference := r.prefix.configReference(f.Reference)
+		if reference == "" || (existing[f.Name] != "" && equivalentFieldReference(existing[f.Name], reference)) {
+			continue
+		}
+		collectionName, fieldName, _ := strings.Cut(normalizeFieldReference(reference), ".")
+		if !plan.Name.IsUnknown() && collectionName == plan.Name.ValueString() {
+			continue
+		}
+
+		collection, looked := collections[collectionName]
+		if !looked {
+			var err error
+			collection, err = r.client.GetCollection(ctx, r.prefix.serverName(collectionName))
+			if err != nil {
+				continue
+			}
+			collections[collectionName] = collection
+		}
+
+		fieldPath := path.Root("field").AtListIndex(i).AtName("reference")
+		if collection == nil {
+			resp.Diagnostics.AddAttributeWarning(
+				fieldPath,
+				"Referenced Collection Not Found",
+				fmt.Sprintf("Field %q references collection %q, which does not exist yet. Creating the field will fail unless %q is created earlier in the same apply.", f.Name, collectionName, collectionName),
+			)
+			continue
+		}
+		if fieldName != "id" && !slices.ContainsFunc(collection.Fields, func(cf client.CollectionField) bool { return cf.Name == fieldName }) {
+			resp.Diagnostics.AddAttributeWarning(
+				fieldPath,
+				"Referenced Field Not Found",
+				fmt.Sprintf("Field %q references %q, but collection %q has no field %q.", f.Name, reference, collectionName, fieldName),
+			)
+		}
+	}
+}
+
+// defaultFieldSort is the sort value Typesense gives an indexed field of
+// each type when the schema leaves it unset. Types not listed (arrays other
+// than string[], objects, auto, vectors) are left for the server to report.
+var defaultFieldSort = map[string]bool{
+	"int32":    true,
+	"int64":    true,
+	"float":    true,
+	"bool":     true,
+	"geopoint": true,
+	"string":   false,
+	"string[]": false,
+}
+
+// fieldSortDefault plans an unset sort as the value the server will report,
+// so the plan does not show it as known after apply. A field at the same
+// position with the same name and type in the prior state keeps its value;
+// otherwise the server default for the field type is used. Fields with
+// index = false and types without a known default stay unknown.
+type fieldSortDefault struct{}
+
+var _ planmodifier.Bool = fieldSortDefault{}
+
+func (m fieldSortDefault) Description(ctx context.Context) string {
+	return "An unset sort is planned as the server default for the field type."
+}
+
+func (m fieldSortDefault) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m fieldSortDefault) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if !req.ConfigValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	fieldPath := req.Path.ParentPath()
+	var name, fieldType types.String
+	var index types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, fieldPath.AtName("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, fieldPath.AtName("type"), &fieldType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, fieldPath.AtName("index"), &index)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() || fieldType.IsUnknown() || index.IsUnknown() || (!index.IsNull() && !index.ValueBool()) {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !req.StateValue.IsNull() && !req.StateValue.IsUnknown() {
+		// The prior field may not exist at this position; a failed lookup
+		// just means there is no value to keep.
+		var stateName, stateType types.String
+		var diags diag.Diagnostics
+		diags.Append(req.State.GetAttribute(ctx, fieldPath.AtName("name"), &stateName)...)
+		diags.Append(req.State.GetAttribute(ctx, fieldPath.AtName("type"), &stateType)...)
+		if !diags.HasError() && stateName.Equal(name) && stateType.Equal(fieldType) {
+			resp.PlanValue = req.StateValue
+			return
+		}
+	}
+
+	if sort, ok := defaultFieldSort[fieldType.ValueString()]; ok {
+		resp.PlanValue = types.BoolValue(sort)
+	}
+}
@@ -0,0 +1,520 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionFieldChangesRenameIsAddAndDrop(t *testing.T) {
+	current := []client.CollectionField{
+		{Name: "title", Type: "string"},
+		{Name: "desc", Type: "string"},
+	}
+	planned := []client.CollectionField{
+		{Name: "title", Type: "string"},
+		{Name: "description", Type: "string"},
+	}
+
+	got := collectionFieldChanges(current, planned)
+
+	if len(got) != 2 {
+		t.Fatalf("got %d field changes, want 2: %+v", len(got), got)
+	}
+	if got[0].Name != "description" || got[0].Drop || got[0].Type != "string" {
+		t.Errorf("first change = %+v, want add of description", got[0])
+	}
+	if got[1].Name != "desc" || !got[1].Drop {
+		t.Errorf("second change = %+v, want drop of desc", got[1])
+	}
+}
+
+func TestCollectionFieldChangesReAddKeepsNumericSortDefault(t *testing.T) {
+	sortTrue := true
+	current := []client.CollectionField{
+		{Name: "price", Type: "int32", Sort: &sortTrue},
+		{Name: "title", Type: "string"},
+	}
+	// sort is computed, so it is unset in the plan when another attribute changes.
+	planned := []client.CollectionField{
+		{Name: "price", Type: "int32", Facet: true},
+		{Name: "title", Type: "string"},
+	}
+
+	got := collectionFieldChanges(current, planned)
+
+	if len(got) != 2 {
+		t.Fatalf("got %d field changes, want drop and re-add of price: %+v", len(got), got)
+	}
+	if got[0].Name != "price" || !got[0].Drop {
+		t.Errorf("first change = %+v, want drop of price", got[0])
+	}
+	readd := got[1]
+	if readd.Name != "price" || readd.Drop || !readd.Facet {
+		t.Errorf("second change = %+v, want re-add of price with facet", readd)
+	}
+	if readd.Sort == nil || !*readd.Sort {
+		t.Errorf("re-added price sort = %v, want true (the server default for int32)", readd.Sort)
+	}
+}
+
+func TestCollectionFieldChangesReAddUsesDefaultSortOfType(t *testing.T) {
+	for _, fieldType := range []string{"int64", "float", "bool", "geopoint"} {
+		sortTrue := true
+		current := []client.CollectionField{{Name: "f", Type: fieldType, Sort: &sortTrue}}
+		planned := []client.CollectionField{{Name: "f", Type: fieldType, Facet: true}}
+
+		got := collectionFieldChanges(current, planned)
+
+		if len(got) != 2 || got[1].Sort == nil || !*got[1].Sort {
+			t.Errorf("%s: field changes = %+v, want the re-added field sent with sort = true", fieldType, got)
+		}
+	}
+}
+
+func TestCollectionFieldChangesReAddLeavesStringSortUnset(t *testing.T) {
+	sortFalse := false
+	current := []client.CollectionField{{Name: "title", Type: "string", Sort: &sortFalse}}
+	planned := []client.CollectionField{{Name: "title", Type: "string", Facet: true}}
+
+	got := collectionFieldChanges(current, planned)
+
+	if len(got) != 2 || got[1].Sort != nil {
+		t.Fatalf("field changes = %+v, want drop and re-add of title without sort", got)
+	}
+}
+
+func TestCollectionFieldChangesLeavesUnsetOptionalToServer(t *testing.T) {
+	indexFalse, optionalTrue, optionalFalse := false, true, false
+	// The server made the unindexed field optional on its own.
+	current := []client.CollectionField{{Name: "raw", Type: "string", Index: &indexFalse, Optional: &optionalTrue}}
+
+	if got := collectionFieldChanges(current, []client.CollectionField{{Name: "raw", Type: "string", Index: &indexFalse}}); len(got) != 0 {
+		t.Errorf("unset optional produced field changes %+v, want none", got)
+	}
+	if got := collectionFieldChanges(current, []client.CollectionField{{Name: "raw", Type: "string", Index: &indexFalse, Optional: &optionalFalse}}); len(got) != 2 {
+		t.Errorf("explicit optional = false produced %+v, want the field dropped and re-added", got)
+	}
+}
+
+func TestCollectionFieldChangesReAddsChangedSeparators(t *testing.T) {
+	current := []client.CollectionField{{Name: "sku", Type: "string", TokenSeparators: []string{"-"}, SymbolsToIndex: []string{"#"}}}
+	tests := []struct {
+		name    string
+		planned client.CollectionField
+	}{
+		{name: "symbols_to_index changed", planned: client.CollectionField{Name: "sku", Type: "string", TokenSeparators: []string{"-"}, SymbolsToIndex: []string{"+"}}},
+		{name: "token_separators changed", planned: client.CollectionField{Name: "sku", Type: "string", TokenSeparators: []string{"-", "_"}, SymbolsToIndex: []string{"#"}}},
+		{name: "symbols_to_index cleared", planned: client.CollectionField{Name: "sku", Type: "string", TokenSeparators: []string{"-"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := collectionFieldChanges(current, []client.CollectionField{tt.planned})
+			if len(got) != 2 || !got[0].Drop || got[1].Drop || !slices.Equal(got[1].SymbolsToIndex, tt.planned.SymbolsToIndex) {
+				t.Fatalf("field changes = %+v, want drop and re-add of sku with the planned separators", got)
+			}
+		})
+	}
+
+	if got := collectionFieldChanges(current, current); len(got) != 0 {
+		t.Errorf("field changes = %+v, want none for unchanged separators", got)
+	}
+}
+
+func TestCollectionModifyPlanWarnsOnFieldRename(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []string
+		planned     []string
+		wantWarning bool
+	}{
+		{name: "renamed field", current: []string{"title", "desc"}, planned: []string{"title", "description"}, wantWarning: true},
+		{name: "added field", current: []string{"title"}, planned: []string{"title", "description"}},
+		{name: "dropped field", current: []string{"title", "desc"}, planned: []string{"title"}},
+		{name: "unchanged", current: []string{"title"}, planned: []string{"title"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			state := collectionPlanWithFields(t, r, tt.current)
+			plan := collectionPlanWithFields(t, r, tt.planned)
+
+			req := resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: state.Schema, Raw: state.Raw},
+			}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if got := len(warnings) > 0; got != tt.wantWarning {
+				t.Fatalf("warning = %v, want %v: %v", got, tt.wantWarning, resp.Diagnostics)
+			}
+			if tt.wantWarning && !strings.Contains(warnings[0].Detail(), "NOT copied") {
+				t.Errorf("warning detail = %q, want it to say data is not copied", warnings[0].Detail())
+			}
+		})
+	}
+}
+
+// collectionPlanWithFields builds a "products" collection plan with a string
+// field per name; other field attributes are null.
+func collectionPlanWithFields(t *testing.T, r *CollectionResource, names []string) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	fields := make([]attr.Value, len(names))
+	for i, name := range names {
+		values := map[string]attr.Value{}
+		for attrName, typ := range fieldAttrTypes() {
+			null, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+			if err != nil {
+				t.Fatalf("failed to build null %s: %v", attrName, err)
+			}
+			values[attrName] = null
+		}
+		values["name"] = types.StringValue(name)
+		values["type"] = types.StringValue("string")
+		fields[i] = types.ObjectValueMust(fieldAttrTypes(), values)
+	}
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.SetAttribute(ctx, path.Root("name"), "products")
+	diags.Append(plan.SetAttribute(ctx, path.Root("field"), types.ListValueMust(types.ObjectType{AttrTypes: fieldAttrTypes()}, fields))...)
+	if diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+	return plan
+}
+
+func TestUpdateModelFromCollectionKeepsDeclaredFieldOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		declared     []string
+		serverFields []string
+		want         []string
+	}{
+		{
+			name:         "server moved a re-added field to the end",
+			declared:     []string{"rating", "title", "author"},
+			serverFields: []string{"title", "author", "rating"},
+			want:         []string{"rating", "title", "author"},
+		},
+		{
+			name:         "implicit id declared between fields",
+			declared:     []string{"title", "id", "rating"},
+			serverFields: []string{"title", "rating"},
+			want:         []string{"title", "id", "rating"},
+		},
+		{
+			name:         "fields added outside Terraform go last",
+			declared:     []string{"id", "rating", "title"},
+			serverFields: []string{"added", "title", "rating"},
+			want:         []string{"id", "rating", "title", "added"},
+		},
+		{
+			name:         "fields dropped outside Terraform disappear",
+			declared:     []string{"title", "rating"},
+			serverFields: []string{"rating"},
+			want:         []string{"rating"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			plan := collectionPlanWithFields(t, r, tt.declared)
+
+			var data CollectionResourceModel
+			if diags := plan.Get(ctx, &data); diags.HasError() {
+				t.Fatalf("failed to read plan: %v", diags)
+			}
+
+			collection := &client.Collection{Name: "products"}
+			for _, name := range tt.serverFields {
+				collection.Fields = append(collection.Fields, client.CollectionField{Name: name, Type: "string"})
+			}
+			r.updateModelFromCollection(ctx, &data, collection)
+
+			var fields []CollectionFieldModel
+			if diags := data.Fields.ElementsAs(ctx, &fields, false); diags.HasError() {
+				t.Fatalf("failed to read fields: %v", diags)
+			}
+			var got []string
+			for _, f := range fields {
+				got = append(got, f.Name.ValueString())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateModelFromCollectionKeepsAutoFieldTypes(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	plan := collectionPlanWithFields(t, r, []string{"title", "price", ".*_facet"})
+
+	var data CollectionResourceModel
+	if diags := plan.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to read plan: %v", diags)
+	}
+	var declared []CollectionFieldModel
+	if diags := data.Fields.ElementsAs(ctx, &declared, false); diags.HasError() {
+		t.Fatalf("failed to read fields: %v", diags)
+	}
+	declared[1].Type = types.StringValue("auto")
+	declared[2].Type = types.StringValue("auto")
+	fields, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: fieldAttrTypes()}, declared)
+	if diags.HasError() {
+		t.Fatalf("failed to build fields: %v", diags)
+	}
+	data.Fields = fields
+
+	// The server reports the types it detected from indexed documents, and a
+	// field for each document key matched by the .*_facet pattern.
+	r.updateModelFromCollection(ctx, &data, &client.Collection{
+		Name: "products",
+		Fields: []client.CollectionField{
+			{Name: "title", Type: "string"},
+			{Name: "price", Type: "float"},
+			{Name: ".*_facet", Type: "auto"},
+			{Name: "brand_facet", Type: "string"},
+		},
+	})
+
+	var got []CollectionFieldModel
+	if diags := data.Fields.ElementsAs(ctx, &got, false); diags.HasError() {
+		t.Fatalf("failed to read fields: %v", diags)
+	}
+	var gotTypes []string
+	for _, f := range got {
+		gotTypes = append(gotTypes, f.Name.ValueString()+":"+f.Type.ValueString())
+	}
+	want := []string{"title:string", "price:auto", ".*_facet:auto"}
+	if !slices.Equal(gotTypes, want) {
+		t.Errorf("fields = %v, want %v", gotTypes, want)
+	}
+}
+
+func TestCollectionEnableNestedFieldsRequiresReplace(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+	state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+
+	attribute, ok := plan.Schema.GetAttributes()["enable_nested_fields"].(schema.BoolAttribute)
+	if !ok {
+		t.Fatal("enable_nested_fields should be a bool attribute")
+	}
+
+	for _, tt := range []struct {
+		prior, planned bool
+		want           bool
+	}{
+		{prior: false, planned: true, want: true},
+		{prior: true, planned: false, want: true},
+		{prior: true, planned: true, want: false},
+	} {
+		req := planmodifier.BoolRequest{
+			Plan:       plan,
+			PlanValue:  types.BoolValue(tt.planned),
+			State:      state,
+			StateValue: types.BoolValue(tt.prior),
+		}
+		resp := &planmodifier.BoolResponse{PlanValue: req.PlanValue}
+		for _, modifier := range attribute.PlanModifiers {
+			modifier.PlanModifyBool(ctx, req, resp)
+		}
+		if resp.RequiresReplace != tt.want {
+			t.Errorf("enable_nested_fields %v -> %v: RequiresReplace = %v, want %v", tt.prior, tt.planned, resp.RequiresReplace, tt.want)
+		}
+	}
+}
+
+func TestUpdateModelFromCollectionSkipDocumentCount(t *testing.T) {
+	for _, skip := range []bool{false, true} {
+		ctx := context.Background()
+		r := &CollectionResource{}
+		plan := collectionPlanWithFields(t, r, []string{"title"})
+		var data CollectionResourceModel
+		if diags := plan.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("failed to read plan: %v", diags)
+		}
+		data.SkipDocumentCount = types.BoolValue(skip)
+
+		r.updateModelFromCollection(ctx, &data, &client.Collection{
+			Name:         "products",
+			Fields:       []client.CollectionField{{Name: "title", Type: "string"}},
+			NumDocuments: 250000000,
+		})
+
+		if skip && !data.NumDocuments.IsNull() {
+			t.Errorf("skip_document_count = true: num_documents = %s, want null", data.NumDocuments)
+		}
+		if !skip && data.NumDocuments.ValueInt64() != 250000000 {
+			t.Errorf("skip_document_count = false: num_documents = %s, want 250000000", data.NumDocuments)
+		}
+	}
+}
+
+func TestUpdateModelFromCollectionKeepsEquivalentLocale(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	plan := collectionPlanWithFields(t, r, []string{"title_ja", "title_zh", "title_ko"})
+
+	var data CollectionResourceModel
+	if diags := plan.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to read plan: %v", diags)
+	}
+	var declared []CollectionFieldModel
+	if diags := data.Fields.ElementsAs(ctx, &declared, false); diags.HasError() {
+		t.Fatalf("failed to read fields: %v", diags)
+	}
+	declared[0].Locale = types.StringValue("JA")
+	declared[1].Locale = types.StringValue("zh_Hant")
+	declared[2].Locale = types.StringValue("ko")
+	fields, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: fieldAttrTypes()}, declared)
+	if diags.HasError() {
+		t.Fatalf("failed to build fields: %v", diags)
+	}
+	data.Fields = fields
+
+	r.updateModelFromCollection(ctx, &data, &client.Collection{
+		Name: "products",
+		Fields: []client.CollectionField{
+			{Name: "title_ja", Type: "string", Locale: "ja"},
+			{Name: "title_zh", Type: "string", Locale: "zh-hant"},
+			{Name: "title_ko", Type: "string", Locale: "ja"},
+		},
+	})
+
+	var got []CollectionFieldModel
+	if diags := data.Fields.ElementsAs(ctx, &got, false); diags.HasError() {
+		t.Fatalf("failed to read fields: %v", diags)
+	}
+	var gotLocales []string
+	for _, f := range got {
+		gotLocales = append(gotLocales, f.Name.ValueString()+":"+f.Locale.ValueString())
+	}
+	// A locale that really changed on the server is reported as drift.
+	want := []string{"title_ja:JA", "title_zh:zh_Hant", "title_ko:ja"}
+	if !slices.Equal(gotLocales, want) {
+		t.Errorf("locales = %v, want %v", gotLocales, want)
+	}
+
+	if collectionFieldChanged(client.CollectionField{Name: "title_ja", Type: "string", Locale: "ja"}, client.CollectionField{Name: "title_ja", Type: "string", Locale: "JA"}) {
+		t.Error("collectionFieldChanged() = true for locales that only differ in case")
+	}
+}
+
+func TestFieldSortDefaultPlansServerDefault(t *testing.T) {
+	tests := []struct {
+		name      string
+		fieldType string
+		index     types.Bool
+		stateSort types.Bool
+		want      types.Bool
+	}{
+		{name: "int32 sorts by default", fieldType: "int32", index: types.BoolValue(true), want: types.BoolValue(true)},
+		{name: "geopoint sorts by default", fieldType: "geopoint", index: types.BoolValue(true), want: types.BoolValue(true)},
+		{name: "string does not sort by default", fieldType: "string", index: types.BoolValue(true), want: types.BoolValue(false)},
+		{name: "object has no known default", fieldType: "object", index: types.BoolValue(true), want: types.BoolUnknown()},
+		{name: "unindexed field is left to the server", fieldType: "int32", index: types.BoolValue(false), want: types.BoolUnknown()},
+		{name: "same field in state keeps its value", fieldType: "int32", index: types.BoolValue(true), stateSort: types.BoolValue(false), want: types.BoolValue(false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			sortPath := path.Root("field").AtListIndex(0).AtName("sort")
+
+			plan := collectionPlanWithFields(t, r, []string{"rating"})
+			diags := plan.SetAttribute(ctx, path.Root("field").AtListIndex(0).AtName("type"), tt.fieldType)
+			diags.Append(plan.SetAttribute(ctx, path.Root("field").AtListIndex(0).AtName("index"), tt.index)...)
+			if diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+
+			req := planmodifier.BoolRequest{
+				Path:        sortPath,
+				Plan:        plan,
+				State:       tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+				ConfigValue: types.BoolNull(),
+				PlanValue:   types.BoolUnknown(),
+				StateValue:  types.BoolNull(),
+			}
+			if !tt.stateSort.IsNull() {
+				req.State.Raw = plan.Raw.Copy()
+				diags.Append(req.State.SetAttribute(ctx, sortPath, tt.stateSort)...)
+				if diags.HasError() {
+					t.Fatalf("failed to build state: %v", diags)
+				}
+				req.StateValue = tt.stateSort
+			}
+
+			resp := planmodifier.BoolResponse{PlanValue: req.PlanValue}
+			fieldSortDefault{}.PlanModifyBool(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("planned sort = %v, want %v", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldSortDefaultIgnoresStateOfAnotherType(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	fieldPath := path.Root("field").AtListIndex(0)
+
+	state := collectionPlanWithFields(t, r, []string{"rating"})
+	diags := state.SetAttribute(ctx, fieldPath.AtName("sort"), types.BoolValue(false))
+	plan := collectionPlanWithFields(t, r, []string{"rating"})
+	diags.Append(plan.SetAttribute(ctx, fieldPath.AtName("type"), "float")...)
+	if diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	req := planmodifier.BoolRequest{
+		Path:        fieldPath.AtName("sort"),
+		Plan:        plan,
+		State:       tfsdk.State{Schema: state.Schema, Raw: state.Raw},
+		ConfigValue: types.BoolNull(),
+		PlanValue:   types.BoolUnknown(),
+		StateValue:  types.BoolValue(false),
+	}
+	resp := planmodifier.BoolResponse{PlanValue: req.PlanValue}
+	fieldSortDefault{}.PlanModifyBool(ctx, req, &resp)
+	if !resp.PlanValue.Equal(types.BoolValue(true)) {
+		t.Errorf("planned sort = %v, want true for a string field changed to float", resp.PlanValue)
+	}
+}
@@ -0,0 +1,35 @@
+package resources
+
+import "strings"
+
+// collectionNamePrefix is the provider's collection_name_prefix. Resources
+// keep the unprefixed collection and alias names in configuration and use
+// serverName for every API call, so the same configuration can be applied to
+// several environments sharing one Typesense server.
+type collectionNamePrefix string
+
+// serverName returns the name of a collection or alias on the server.
+func (p collectionNamePrefix) serverName(name string) string {
+	return string(p) + name
+}
+
+// configName returns the name used in configuration for a server name. Names
+// without the prefix are returned unchanged.
+func (p collectionNamePrefix) configName(name string) string {
+	return strings.TrimPrefix(name, string(p))
+}
+
+// serverReference returns a field reference as sent to the server: the
+// referenced collection gets the prefix, the field after the dot is kept.
+func (p collectionNamePrefix) serverReference(ref string) string {
+	if ref == "" {
+		return ref
+	}
+	return p.serverName(ref)
+}
+
+// configReference returns a field reference reported by the server as written
+// in configuration, without the prefix on the referenced collection.
+func (p collectionNamePrefix) configReference(ref string) string {
+	return p.configName(ref)
+}
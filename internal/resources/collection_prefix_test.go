@@ -0,0 +1,182 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionCreateWithNamePrefix(t *testing.T) {
+	var created map[string]any
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/collections" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		created["created_at"] = 1700000000
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(created)
+	})
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler), prefix: "staging_"}
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if created["name"] != "staging_products" {
+		t.Errorf("created collection %v, want staging_products", created["name"])
+	}
+
+	var id, name types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if id.ValueString() != "staging_products" {
+		t.Errorf("id = %s, want the server name staging_products", id)
+	}
+	if name.ValueString() != "products" {
+		t.Errorf("name = %s, want the configured name products", name)
+	}
+}
+
+func TestCollectionFieldReferenceWithNamePrefix(t *testing.T) {
+	var sent string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var created map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+			return
+		}
+		sent, _ = created["fields"].([]any)[0].(map[string]any)["reference"].(string)
+		created["created_at"] = 1700000000
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(created)
+	})
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler), prefix: "staging_"}
+	plan := collectionPlanWithFields(t, r, []string{"author_id"})
+	referencePath := path.Root("field").AtListIndex(0).AtName("reference")
+	if diags := plan.SetAttribute(ctx, referencePath, "authors.id"); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if sent != "staging_authors.id" {
+		t.Errorf("sent reference %q, want staging_authors.id", sent)
+	}
+	var reference types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, referencePath, &reference)...)
+	if reference.ValueString() != "authors.id" {
+		t.Errorf("reference = %s, want the configured authors.id", reference)
+	}
+}
+
+func TestCollectionModifyPlanPrefixesID(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{prefix: "staging_"}
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+
+	req := resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan returned errors: %v", resp.Diagnostics)
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	if id.ValueString() != "staging_products" {
+		t.Errorf("planned id = %s, want staging_products", id)
+	}
+}
+
+func TestCollectionImportAcceptsPrefixedName(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{prefix: "staging_"}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	for _, importID := range []string{"staging_products", "products"} {
+		resp := resource.ImportStateResponse{State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: importID}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("ImportState(%s) returned errors: %v", importID, resp.Diagnostics)
+		}
+
+		var id, name types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("name"), &name)...)
+		if id.ValueString() != "staging_products" || name.ValueString() != "products" {
+			t.Errorf("ImportState(%s) = id %s, name %s, want staging_products and products", importID, id, name)
+		}
+	}
+}
+
+func TestCollectionAliasReadWithNamePrefix(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/aliases/staging_products" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "staging_products", "collection_name": "staging_products_v2"}`))
+	})
+
+	ctx := context.Background()
+	r := &CollectionAliasResource{client: newTestServerClient(t, handler), prefix: "staging_"}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.SetAttribute(ctx, path.Root("id"), "staging_products")
+	diags.Append(state.SetAttribute(ctx, path.Root("name"), "products")...)
+	diags.Append(state.SetAttribute(ctx, path.Root("collection_name"), "products_v1")...)
+	if diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var collectionName types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("collection_name"), &collectionName)...)
+	if collectionName.ValueString() != "products_v2" {
+		t.Errorf("collection_name = %s, want products_v2 without the prefix", collectionName)
+	}
+}
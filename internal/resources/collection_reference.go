@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// normalizeFieldReference returns a field reference in its full
+// collection.field form. A reference without a field joins on the id of the
+// referenced collection, so "authors" and "authors.id" are the same reference
+// and the server may report either.
+func normalizeFieldReference(ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || strings.Contains(ref, ".") {
+		return ref
+	}
+	return ref + ".id"
+}
+
+// equivalentFieldReference reports whether two references point at the same
+// collection field.
+func equivalentFieldReference(a, b string) bool {
+	return normalizeFieldReference(a) == normalizeFieldReference(b)
+}
+
+// fieldReferenceRequiresReplace replaces the collection when a field's
+// reference changes, but not when the old and new values only differ in how
+// the same reference is written, e.g. after importing a collection whose
+// references the server reports in another form.
+func fieldReferenceRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !equivalentFieldReference(req.StateValue.ValueString(), req.PlanValue.ValueString())
+		},
+		"Changing the referenced collection field replaces the collection.",
+		"Changing the referenced collection field replaces the collection.",
+	)
+}
@@ -0,0 +1,140 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionModifyPlanWarnsOnMissingReferences(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/collections/authors":
+			_, _ = w.Write([]byte(`{"name": "authors", "fields": [{"name": "name", "type": "string"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	})
+
+	tests := []struct {
+		name      string
+		reference string
+		want      string
+	}{
+		{name: "implicit id of existing collection", reference: "authors"},
+		{name: "declared field", reference: "authors.name"},
+		{name: "missing field", reference: "authors.email", want: "Referenced Field Not Found"},
+		{name: "missing collection", reference: "publishers.id", want: "Referenced Collection Not Found"},
+		{name: "own collection", reference: "products.id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{client: newTestServerClient(t, handler)}
+			plan := collectionPlanWithFields(t, r, []string{"author_id"})
+			if diags := plan.SetAttribute(ctx, path.Root("field").AtListIndex(0).AtName("reference"), tt.reference); diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			warnings := resp.Diagnostics.Warnings()
+			if tt.want == "" {
+				if len(warnings) != 0 {
+					t.Errorf("got warnings %v, want none", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0].Summary() != tt.want {
+				t.Errorf("got warnings %v, want %s", warnings, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectionModifyPlanSkipsUnchangedReferences(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s for an unchanged reference", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler)}
+	plan := collectionPlanWithFields(t, r, []string{"author_id"})
+	if diags := plan.SetAttribute(ctx, path.Root("field").AtListIndex(0).AtName("reference"), "authors.id"); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+	state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}
+	if diags := state.SetAttribute(ctx, path.Root("field").AtListIndex(0).AtName("reference"), "authors"); diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+
+	if len(resp.Diagnostics.Warnings()) != 0 {
+		t.Errorf("got warnings %v, want none", resp.Diagnostics.Warnings())
+	}
+}
+
+func TestCollectionModifyPlanLooksUpReferencesWithNamePrefix(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/collections/staging_authors" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "staging_authors", "fields": [{"name": "name", "type": "string"}]}`))
+	})
+
+	tests := []struct {
+		reference string
+		want      string
+	}{
+		{reference: "authors.name"},
+		{reference: "authors.email", want: `Field "author_id" references "authors.email", but collection "authors" has no field "email".`},
+		{reference: "products.id"},
+	}
+
+	for _, tt := range tests {
+		ctx := context.Background()
+		r := &CollectionResource{client: newTestServerClient(t, handler), prefix: "staging_"}
+		plan := collectionPlanWithFields(t, r, []string{"author_id"})
+		if diags := plan.SetAttribute(ctx, path.Root("field").AtListIndex(0).AtName("reference"), tt.reference); diags.HasError() {
+			t.Fatalf("failed to build plan: %v", diags)
+		}
+
+		req := resource.ModifyPlanRequest{
+			Plan:  plan,
+			State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+		}
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, req, &resp)
+
+		warnings := resp.Diagnostics.Warnings()
+		if tt.want == "" {
+			if len(warnings) != 0 {
+				t.Errorf("reference %q: got warnings %v, want none", tt.reference, warnings)
+			}
+			continue
+		}
+		if len(warnings) != 1 || warnings[0].Detail() != tt.want {
+			t.Errorf("reference %q: got warnings %v, want %q", tt.reference, warnings, tt.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// collectionNameRequiresReplace forces a new collection when the name changes,
+// unless rename_via_reindex is set, in which case Update copies the documents
+// into a collection with the new name instead.
+func collectionNameRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var renameViaReindex types.Bool
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rename_via_reindex"), &renameViaReindex)...)
+			resp.RequiresReplace = !renameViaReindex.ValueBool()
+		},
+		"Changing the name replaces the collection unless rename_via_reindex is true.",
+		"Changing the name replaces the collection unless `rename_via_reindex` is true.",
+	)
+}
+
+// collectionIDFromName plans the id as the planned name. The id of a
+// collection is its name, so it is known up front and follows a rename.
+// ModifyPlan adds the provider's collection_name_prefix, if any.
+type collectionIDFromName struct{}
+
+var _ planmodifier.String = collectionIDFromName{}
+
+func (m collectionIDFromName) Description(ctx context.Context) string {
+	return "The id is always the collection name."
+}
+
+func (m collectionIDFromName) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m collectionIDFromName) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if name.IsUnknown() || name.IsNull() {
+		return
+	}
+	resp.PlanValue = name
+}
+
+// renameViaReindex moves a collection to the planned name by creating the new
+// collection, copying every document into it and deleting the old one.
+func (r *CollectionResource) renameViaReindex(ctx context.Context, oldName string, data *CollectionResourceModel, resp *resource.UpdateResponse) {
+	target, diags := r.modelToCollection(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.Reindex(ctx, oldName, target); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename collection %s to %s: %s", oldName, target.Name, err))
+		return
+	}
+
+	collection, err := r.client.GetCollection(ctx, target.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read collection after rename: %s", err))
+		return
+	}
+	if collection == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Collection %s not found after rename", target.Name))
+		return
+	}
+
+	r.updateModelFromCollection(ctx, data, collection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionRenamePlan(t *testing.T) {
+	tests := []struct {
+		name             string
+		renameViaReindex bool
+		stateName        string
+		planName         string
+		wantReplace      bool
+	}{
+		{name: "rename replaces by default", stateName: "products", planName: "products_v2", wantReplace: true},
+		{name: "rename via reindex updates in place", renameViaReindex: true, stateName: "products", planName: "products_v2"},
+		{name: "unchanged name", stateName: "products", planName: "products"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := collectionPlanWithName(t, tt.stateName, tt.renameViaReindex)
+			plan := collectionPlanWithName(t, tt.planName, tt.renameViaReindex)
+
+			nameReq := planmodifier.StringRequest{
+				Path:        path.Root("name"),
+				Plan:        plan,
+				State:       tfsdk.State{Schema: state.Schema, Raw: state.Raw},
+				Config:      tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+				ConfigValue: types.StringValue(tt.planName),
+				PlanValue:   types.StringValue(tt.planName),
+				StateValue:  types.StringValue(tt.stateName),
+			}
+			var nameResp planmodifier.StringResponse
+			collectionNameRequiresReplace().PlanModifyString(ctx, nameReq, &nameResp)
+			if nameResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", nameResp.Diagnostics)
+			}
+			if nameResp.RequiresReplace != tt.wantReplace {
+				t.Errorf("RequiresReplace = %v, want %v", nameResp.RequiresReplace, tt.wantReplace)
+			}
+
+			idReq := planmodifier.StringRequest{
+				Path:       path.Root("id"),
+				Plan:       plan,
+				PlanValue:  types.StringUnknown(),
+				StateValue: types.StringValue(tt.stateName),
+			}
+			var idResp planmodifier.StringResponse
+			collectionIDFromName{}.PlanModifyString(ctx, idReq, &idResp)
+			if got := idResp.PlanValue.ValueString(); got != tt.planName {
+				t.Errorf("planned id = %q, want %q", got, tt.planName)
+			}
+		})
+	}
+}
+
+// collectionPlanWithName builds a collection plan with only name and
+// rename_via_reindex set.
+func collectionPlanWithName(t *testing.T, name string, renameViaReindex bool) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	(&CollectionResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.SetAttribute(ctx, path.Root("name"), name)
+	diags.Append(plan.SetAttribute(ctx, path.Root("rename_via_reindex"), renameViaReindex)...)
+	if diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+	return plan
+}
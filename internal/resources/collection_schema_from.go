@@ -0,0 +1,35 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// sourceSchemaFields returns the fields of the schema_from collection, without
+// the flattened fields the server derives from object fields, so they can be
+// sent as the schema of a new collection.
+func (r *CollectionResource) sourceSchemaFields(ctx context.Context, source string) ([]client.CollectionField, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	collection, err := r.client.GetCollection(ctx, source)
+	if err != nil {
+		diags.AddAttributeError(path.Root("schema_from"), "Client Error",
+			fmt.Sprintf("Unable to read source collection %s: %s", source, err))
+		return nil, diags
+	}
+	if collection == nil {
+		diags.AddAttributeError(path.Root("schema_from"), "Source Collection Not Found",
+			fmt.Sprintf("schema_from refers to collection %q, which does not exist on the server. Create it first or correct the name.", source))
+		return nil, diags
+	}
+
+	fields := declaredCollectionFields(collection.Fields, nil)
+	for i := range fields {
+		fields[i].Nested = false
+	}
+	return fields, diags
+}
@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionCreateCopiesSchemaFrom(t *testing.T) {
+	var created map[string]any
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/collections/products":
+			_, _ = w.Write([]byte(`{"name": "products", "enable_nested_fields": true, "fields": [
+				{"name": "title", "type": "string"},
+				{"name": "person", "type": "object"},
+				{"name": "person.name", "type": "string", "nested": true}
+			]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/collections":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			created["created_at"] = 1700000000
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(created)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler)}
+	plan := collectionPlanWithFields(t, r, nil)
+	diags := plan.SetAttribute(ctx, path.Root("name"), "products_v2")
+	diags.Append(plan.SetAttribute(ctx, path.Root("schema_from"), "products")...)
+	if diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	fields, _ := created["fields"].([]any)
+	if len(fields) != 2 {
+		t.Fatalf("created collection with fields %v, want title and person without the flattened person.name", created["fields"])
+	}
+	for i, want := range []string{"title", "person"} {
+		field := fields[i].(map[string]any)
+		if field["name"] != want {
+			t.Errorf("field %d = %v, want %s", i, field["name"], want)
+		}
+		if _, ok := field["nested"]; ok {
+			t.Errorf("field %s was sent with the server-only nested flag", want)
+		}
+	}
+
+	var stateFields types.List
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("field"), &stateFields)...)
+	if len(stateFields.Elements()) != 0 {
+		t.Errorf("state has %d field blocks, want none as configured", len(stateFields.Elements()))
+	}
+}
+
+func TestCollectionModifyPlanWarnsOnMissingSchemaFrom(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler)}
+	plan := collectionPlanWithFields(t, r, nil)
+	if diags := plan.SetAttribute(ctx, path.Root("schema_from"), "missing"); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	req := resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || warnings[0].Summary() != "Source Collection Not Found" {
+		t.Fatalf("got warnings %v, want Source Collection Not Found", warnings)
+	}
+}
+
+func TestCollectionCreateFailsWhenSchemaFromMissing(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler)}
+	plan := collectionPlanWithFields(t, r, nil)
+	if diags := plan.SetAttribute(ctx, path.Root("schema_from"), "missing"); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 || errs[0].Summary() != "Source Collection Not Found" {
+		t.Fatalf("got errors %v, want Source Collection Not Found", errs)
+	}
+}
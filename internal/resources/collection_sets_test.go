@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionUpdateSendsSetAssociations(t *testing.T) {
+	var patch string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			body, _ := io.ReadAll(r.Body)
+			patch = string(body)
+			_, _ = w.Write(body)
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"name": "products", "fields": [{"name": "title", "type": "string"}], "synonym_sets": ["shared-synonyms"]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	r := &CollectionResource{client: newTestServerClient(t, handler)}
+	prior := collectionPlanWithFields(t, r, []string{"title"})
+	state := tfsdk.State{Schema: prior.Schema, Raw: prior.Raw}
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+	if diags := plan.SetAttribute(ctx, path.Root("synonym_sets"), []string{"shared-synonyms"}); diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", resp.Diagnostics)
+	}
+
+	if want := `{"curation_sets":[],"synonym_sets":["shared-synonyms"]}`; patch != want {
+		t.Errorf("PATCH body = %s, want %s", patch, want)
+	}
+	var synonymSets, curationSets types.List
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("synonym_sets"), &synonymSets)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("curation_sets"), &curationSets)...)
+	if want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("shared-synonyms")}); !synonymSets.Equal(want) {
+		t.Errorf("synonym_sets = %s, want %s", synonymSets, want)
+	}
+	if !curationSets.IsNull() {
+		t.Errorf("curation_sets = %s, want null", curationSets)
+	}
+}
+
+func TestUpdateModelFromCollectionSetAssociationDrift(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+	plan := collectionPlanWithFields(t, r, []string{"title"})
+	var data CollectionResourceModel
+	if diags := plan.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to read plan: %v", diags)
+	}
+	data.CurationSets = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("promotions")})
+
+	r.updateModelFromCollection(ctx, &data, &client.Collection{
+		Name:   "products",
+		Fields: []client.CollectionField{{Name: "title", Type: "string"}},
+	})
+
+	if !data.SynonymSets.IsNull() {
+		t.Errorf("synonym_sets = %s, want null when neither config nor server has sets", data.SynonymSets)
+	}
+	if data.CurationSets.IsNull() || len(data.CurationSets.Elements()) != 0 {
+		t.Errorf("curation_sets = %s, want an empty list after the server dropped the association", data.CurationSets)
+	}
+}
+
+func TestCollectionModifyPlanRejectsSetAssociationsBeforeV30(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		checker   version.FeatureChecker
+		wantError bool
+	}{
+		{name: "v29", checker: version.NewFeatureChecker(version.MustParse("29.0")), wantError: true},
+		{name: "v30", checker: version.NewFeatureChecker(version.MustParse("30.0"))},
+		{name: "undetected", checker: version.NewFallbackFeatureChecker()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{featureChecker: tt.checker}
+			plan := collectionPlanWithFields(t, r, []string{"title"})
+			if diags := plan.SetAttribute(ctx, path.Root("synonym_sets"), []string{"shared-synonyms"}); diags.HasError() {
+				t.Fatalf("failed to build plan: %v", diags)
+			}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ModifyPlan errors = %v, want error %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
@@ -1,12 +1,18 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/alanm/terraform-provider-typesense/internal/client"
 	"github.com/alanm/terraform-provider-typesense/internal/provider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccCollectionResource_basic(t *testing.T) {
@@ -39,6 +45,230 @@ func TestAccCollectionResource_basic(t *testing.T) {
 	})
 }
 
+// TestAccCollectionResource_forceDestroy points an alias created outside
+// Terraform at the collection and checks that destroy removes it too.
+func TestAccCollectionResource_forceDestroy(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+	aliasName := acctest.RandomWithPrefix("test-alias")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			alias, err := provider.TestAccServerClient().GetCollectionAlias(context.Background(), aliasName)
+			if err != nil {
+				return err
+			}
+			if alias != nil {
+				return fmt.Errorf("alias %s still points at %s after destroy", aliasName, alias.CollectionName)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionResourceConfig_forceDestroy(rName),
+				Check:  resource.TestCheckResourceAttr("typesense_collection.test", "force_destroy", "true"),
+			},
+			{
+				PreConfig: func() {
+					_, err := provider.TestAccServerClient().UpsertCollectionAlias(context.Background(), &client.CollectionAlias{
+						Name:           aliasName,
+						CollectionName: rName,
+					})
+					if err != nil {
+						t.Fatalf("failed to create alias: %s", err)
+					}
+				},
+				Config: testAccCollectionResourceConfig_forceDestroy(rName),
+			},
+		},
+	})
+}
+
+func TestAccCollectionResource_renameViaReindex(t *testing.T) {
+	oldName := acctest.RandomWithPrefix("test-collection")
+	newName := oldName + "-renamed"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionResourceConfig_renameViaReindex(oldName),
+				Check:  resource.TestCheckResourceAttr("typesense_collection.test", "id", oldName),
+			},
+			{
+				PreConfig: func() {
+					doc := strings.NewReader(`{"id":"1","title":"kept across the rename"}`)
+					if err := provider.TestAccServerClient().ImportDocuments(context.Background(), oldName, doc, ""); err != nil {
+						t.Fatalf("failed to import document: %s", err)
+					}
+				},
+				Config: testAccCollectionResourceConfig_renameViaReindex(newName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("typesense_collection.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "id", newName),
+					resource.TestCheckResourceAttr("typesense_collection.test", "name", newName),
+					resource.TestCheckResourceAttr("typesense_collection.test", "num_documents", "1"),
+					func(*terraform.State) error {
+						old, err := provider.TestAccServerClient().GetCollection(context.Background(), oldName)
+						if err != nil {
+							return err
+						}
+						if old != nil {
+							return fmt.Errorf("collection %s still exists after the rename", oldName)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestAccCollectionResource_importNested imports a nested collection after a
+// document has made the server add flattened fields (person.name, ...) to the
+// schema. The import must match the config without ignoring field.
+func TestAccCollectionResource_importNested(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-nested")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionResourceConfig_nested(rName),
+			},
+			{
+				PreConfig: func() {
+					doc := strings.NewReader(`{"id":"1","title":"nested","person":{"name":"Ada","age":36}}`)
+					if err := provider.TestAccServerClient().ImportDocuments(context.Background(), rName, doc, ""); err != nil {
+						t.Fatalf("failed to import document: %s", err)
+					}
+				},
+				Config: testAccCollectionResourceConfig_nested(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.#", "2"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "num_documents", "1"),
+				),
+			},
+			{
+				ResourceName:      "typesense_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCollectionResource_importReference(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-reference")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionResourceConfig_reference(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.books", "field.1.reference", rName+"-authors.id"),
+				),
+			},
+			{
+				ResourceName:      "typesense_collection.books",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCollectionResource_schemaFrom(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionResourceConfig_schemaFrom(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.clone", "schema_from", rName),
+					resource.TestCheckResourceAttr("typesense_collection.clone", "field.#", "0"),
+					func(*terraform.State) error {
+						clone, err := provider.TestAccServerClient().GetCollection(context.Background(), rName+"-v2")
+						if err != nil {
+							return err
+						}
+						if clone == nil {
+							return fmt.Errorf("collection %s-v2 not found", rName)
+						}
+						var names []string
+						for _, f := range clone.Fields {
+							names = append(names, f.Name)
+						}
+						if strings.Join(names, ",") != "title,rating" {
+							return fmt.Errorf("cloned fields = %v, want title,rating", names)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccCollectionResource_noFields(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %q
+}
+`, rName),
+				ExpectError: regexp.MustCompile(`at least one field block`),
+			},
+		},
+	})
+}
+
+func TestAccCollectionResource_renameField(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionResourceConfig_secondField(rName, "desc"),
+				Check:  resource.TestCheckResourceAttr("typesense_collection.test", "field.1.name", "desc"),
+			},
+			{
+				// The rename is applied in place as add "description" + drop "desc".
+				Config: testAccCollectionResourceConfig_secondField(rName, "description"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("typesense_collection.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.#", "2"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.name", "description"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCollectionResource_full(t *testing.T) {
 	rName := acctest.RandomWithPrefix("test-collection")
 
@@ -88,6 +318,7 @@ func TestAccCollectionResource_update(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("typesense_collection.test", "name", rName),
 					resource.TestCheckResourceAttr("typesense_collection.test", "field.#", "2"),
+					resource.TestCheckNoResourceAttr("typesense_collection.test", "last_modified_at"),
 				),
 			},
 			{
@@ -97,6 +328,7 @@ func TestAccCollectionResource_update(t *testing.T) {
 					resource.TestCheckResourceAttr("typesense_collection.test", "field.#", "3"),
 					resource.TestCheckResourceAttr("typesense_collection.test", "field.2.name", "author"),
 					resource.TestCheckResourceAttr("typesense_collection.test", "field.2.type", "string"),
+					resource.TestCheckResourceAttrSet("typesense_collection.test", "last_modified_at"),
 				),
 			},
 		},
@@ -347,7 +579,8 @@ resource "typesense_collection" "test" {
 }
 
 // TestAccCollectionResource_fieldLevelSeparators tests creating a collection with
-// field-level token_separators and symbols_to_index.
+// field-level token_separators and symbols_to_index, then changing the
+// symbols, which drops and re-adds the field in place.
 func TestAccCollectionResource_fieldLevelSeparators(t *testing.T) {
 	rName := acctest.RandomWithPrefix("test-seps")
 
@@ -356,7 +589,39 @@ func TestAccCollectionResource_fieldLevelSeparators(t *testing.T) {
 		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(`
+				Config: testAccCollectionResourceConfig_fieldLevelSeparators(rName, `["#", "+"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "name", rName),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.#", "2"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.name", "sku"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.token_separators.#", "2"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.token_separators.0", "-"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.token_separators.1", "_"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.symbols_to_index.#", "2"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.symbols_to_index.0", "#"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.symbols_to_index.1", "+"),
+				),
+			},
+			{
+				Config: testAccCollectionResourceConfig_fieldLevelSeparators(rName, `["@"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("typesense_collection.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.name", "sku"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.token_separators.#", "2"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.symbols_to_index.#", "1"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.symbols_to_index.0", "@"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCollectionResourceConfig_fieldLevelSeparators(name, symbols string) string {
+	return fmt.Sprintf(`
 resource "typesense_collection" "test" {
   name = %[1]q
 
@@ -369,22 +634,65 @@ resource "typesense_collection" "test" {
     name             = "sku"
     type             = "string"
     token_separators = ["-", "_"]
-    symbols_to_index = ["#", "+"]
+    symbols_to_index = %[2]s
   }
 }
-`, rName),
+`, name, symbols)
+}
+
+// TestAccCollectionResource_autoFields tests that auto and string* fields,
+// including an auto field whose name is a pattern, plan empty after creation.
+func TestAccCollectionResource_autoFields(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-auto")
+	config := fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name     = "price"
+    type     = "auto"
+    optional = true
+  }
+
+  field {
+    name     = "tags"
+    type     = "string*"
+    optional = true
+  }
+
+  field {
+    name = ".*_facet"
+    type = "auto"
+  }
+}
+`, rName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("typesense_collection.test", "name", rName),
-					resource.TestCheckResourceAttr("typesense_collection.test", "field.#", "2"),
-					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.name", "sku"),
-					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.token_separators.#", "2"),
-					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.token_separators.0", "-"),
-					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.token_separators.1", "_"),
-					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.symbols_to_index.#", "2"),
-					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.symbols_to_index.0", "#"),
-					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.symbols_to_index.1", "+"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.#", "4"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.1.type", "auto"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.2.type", "string*"),
+					resource.TestCheckResourceAttr("typesense_collection.test", "field.3.name", ".*_facet"),
 				),
 			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 		},
 	})
 }
@@ -492,3 +800,120 @@ resource "typesense_collection" "test" {
 		},
 	})
 }
+
+func testAccCollectionResourceConfig_forceDestroy(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name          = %[1]q
+  force_destroy = true
+
+  field {
+    name = "title"
+    type = "string"
+  }
+}
+`, name)
+}
+
+func testAccCollectionResourceConfig_renameViaReindex(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name               = %[1]q
+  rename_via_reindex = true
+
+  field {
+    name = "title"
+    type = "string"
+  }
+}
+`, name)
+}
+
+func testAccCollectionResourceConfig_secondField(name, fieldName string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name     = %[2]q
+    type     = "string"
+    optional = true
+  }
+}
+`, name, fieldName)
+}
+
+func testAccCollectionResourceConfig_nested(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "test" {
+  name                 = %[1]q
+  enable_nested_fields = true
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name = "person"
+    type = "object"
+  }
+}
+`, name)
+}
+
+func testAccCollectionResourceConfig_reference(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "authors" {
+  name = "%[1]s-authors"
+
+  field {
+    name = "name"
+    type = "string"
+  }
+}
+
+resource "typesense_collection" "books" {
+  name = "%[1]s-books"
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name      = "author_id"
+    type      = "string"
+    reference = "${typesense_collection.authors.name}.id"
+  }
+}
+`, name)
+}
+
+func testAccCollectionResourceConfig_schemaFrom(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_collection" "source" {
+  name = %[1]q
+
+  field {
+    name = "title"
+    type = "string"
+  }
+
+  field {
+    name = "rating"
+    type = "int32"
+  }
+}
+
+resource "typesense_collection" "clone" {
+  name        = "%[1]s-v2"
+  schema_from = typesense_collection.source.name
+}
+`, name)
+}
@@ -0,0 +1,237 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithValidateConfig = &CollectionResource{}
+
+// vectorDistanceMetrics are the vec_dist values Typesense accepts for vector
+// fields. Typesense has no l2/euclidean metric.
+var vectorDistanceMetrics = []string{"cosine", "ip"}
+
+// vecDistValidator restricts a field's vec_dist to the supported metrics.
+type vecDistValidator struct{}
+
+var _ validator.String = vecDistValidator{}
+
+func (v vecDistValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(vectorDistanceMetrics, ", "))
+}
+
+func (v vecDistValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v vecDistValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	metric := req.ConfigValue.ValueString()
+	for _, valid := range vectorDistanceMetrics {
+		if metric == valid {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Vector Distance Metric",
+		fmt.Sprintf("%q is not a supported vec_dist. Typesense supports %q (cosine similarity) and %q (inner product).",
+			metric, "cosine", "ip"),
+	)
+}
+
+// ValidateConfig checks per-field attribute combinations the server would
+// reject:
+//
+//   - vec_dist is only set on vector fields, i.e. fields with num_dim > 0 or
+//     an embed block (whose dimensions come from the model).
+//   - facet, sort and infix are not enabled on fields with index = false.
+//
+// It also checks that default_sorting_field names a declared field that can be
+// sorted on (a numeric int32/int64/float field, or one with sort = true), and
+// warns when that field is declared optional or when a geopoint field turns
+// off sort, which geo sorting needs.
+//
+// A collection must declare at least one field, unless schema_from is set, in
+// which case field blocks are ignored and declaring any is a warning.
+func (r *CollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var fields types.List
+	var schemaFrom types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("field"), &fields)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schema_from"), &schemaFrom)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !schemaFrom.IsNull() {
+		if len(fields.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("field"),
+				"Field Blocks Ignored",
+				"schema_from is set, so the collection is created with the fields of the source collection and these field blocks are ignored. Remove them, or remove schema_from to manage the fields here.",
+			)
+		}
+		return
+	}
+
+	if fields.IsUnknown() {
+		return
+	}
+	if len(fields.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("field"),
+			"Missing Collection Fields",
+			"A collection needs at least one field block. Add a field, for example one named \"id\" of type \"string\", or set schema_from to copy the fields of an existing collection.",
+		)
+		return
+	}
+
+	var defaultSortingField types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_sorting_field"), &defaultSortingField)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var fieldModels []CollectionFieldModel
+	resp.Diagnostics.Append(fields.ElementsAs(ctx, &fieldModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, fm := range fieldModels {
+		fieldPath := path.Root("field").AtListIndex(i)
+		validateFieldVecDist(fm, fieldPath, resp)
+		validateFieldIndexDisabled(fm, fieldPath, resp)
+		validateDefaultSortingFieldRequired(fm, defaultSortingField, fieldPath, resp)
+		validateGeopointSort(fm, fieldPath, resp)
+	}
+	validateDefaultSortingFieldSortable(fieldModels, defaultSortingField, resp)
+}
+
+// numericSortTypes are the field types Typesense sorts on by default.
+var numericSortTypes = []string{"int32", "int64", "float"}
+
+func validateDefaultSortingFieldSortable(fieldModels []CollectionFieldModel, defaultSortingField types.String, resp *resource.ValidateConfigResponse) {
+	if defaultSortingField.IsNull() || defaultSortingField.IsUnknown() || defaultSortingField.ValueString() == "" {
+		return
+	}
+	name := defaultSortingField.ValueString()
+
+	for _, fm := range fieldModels {
+		if fm.Name.IsUnknown() {
+			return
+		}
+		if fm.Name.ValueString() != name {
+			continue
+		}
+		if fm.Type.IsUnknown() || fm.Sort.IsUnknown() || fm.Sort.ValueBool() {
+			return
+		}
+		if slices.Contains(numericSortTypes, fm.Type.ValueString()) && fm.Sort.IsNull() {
+			return
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_sorting_field"),
+			"Default Sorting Field Not Sortable",
+			fmt.Sprintf("default_sorting_field %q refers to a field of type %q that is not sortable. Use an int32, int64 or float field, or set sort = true on the field.",
+				name, fm.Type.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("default_sorting_field"),
+		"Unknown Default Sorting Field",
+		fmt.Sprintf("default_sorting_field %q does not match any declared field. Add a field with that name or change default_sorting_field.", name),
+	)
+}
+
+func validateFieldVecDist(fm CollectionFieldModel, fieldPath path.Path, resp *resource.ValidateConfigResponse) {
+	if fm.VecDist.IsNull() || fm.VecDist.IsUnknown() || fm.NumDim.IsUnknown() {
+		return
+	}
+	if fm.NumDim.ValueInt64() > 0 || !fm.Embed.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		fieldPath.AtName("vec_dist"),
+		"vec_dist Requires a Vector Field",
+		fmt.Sprintf("Field %q sets vec_dist but is not a vector field. Set num_dim to a value greater than 0 (or configure embed), or remove vec_dist.",
+			fm.Name.ValueString()),
+	)
+}
+
+func validateFieldIndexDisabled(fm CollectionFieldModel, fieldPath path.Path, resp *resource.ValidateConfigResponse) {
+	if fm.Index.IsNull() || fm.Index.IsUnknown() || fm.Index.ValueBool() {
+		return
+	}
+
+	searchOnly := []struct {
+		name  string
+		value types.Bool
+	}{
+		{name: "facet", value: fm.Facet},
+		{name: "sort", value: fm.Sort},
+		{name: "infix", value: fm.Infix},
+	}
+	for _, opt := range searchOnly {
+		if !opt.value.ValueBool() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			fieldPath.AtName(opt.name),
+			"Conflicting Field Options",
+			fmt.Sprintf("Field %q sets %s = true but also index = false. Non-indexed fields are store-only: they are returned with documents but cannot be searched, faceted, sorted or used for infix matching. Remove %s or set index = true.",
+				fm.Name.ValueString(), opt.name, opt.name),
+		)
+	}
+}
+
+func validateDefaultSortingFieldRequired(fm CollectionFieldModel, defaultSortingField types.String, fieldPath path.Path, resp *resource.ValidateConfigResponse) {
+	if defaultSortingField.IsNull() || defaultSortingField.IsUnknown() || fm.Name.IsUnknown() {
+		return
+	}
+	if fm.Name.ValueString() != defaultSortingField.ValueString() || !fm.Optional.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		fieldPath.AtName("optional"),
+		"Optional Default Sorting Field",
+		fmt.Sprintf("Field %q is the default_sorting_field but is marked optional = true. Every document needs a value for the default sorting field; documents without one are rejected on import or break sorting. Set optional = false or choose a different default_sorting_field.",
+			fm.Name.ValueString()),
+	)
+}
+
+// validateGeopointSort warns when a geopoint field sets sort = false. Typesense
+// sorts geopoints by default, and sort_by clauses such as
+// location(48.85, 2.29):asc fail on a geopoint that is not sortable. It is
+// only a warning, since a geopoint used just for filtering does not need it.
+func validateGeopointSort(fm CollectionFieldModel, fieldPath path.Path, resp *resource.ValidateConfigResponse) {
+	if fm.Sort.IsNull() || fm.Sort.IsUnknown() || fm.Sort.ValueBool() {
+		return
+	}
+	if fieldType := fm.Type.ValueString(); fieldType != "geopoint" && fieldType != "geopoint[]" {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		fieldPath.AtName("sort"),
+		"Geopoint Field Not Sortable",
+		fmt.Sprintf("Field %q is a %s with sort = false, so searches cannot sort by distance from it (e.g. sort_by = \"%s(48.85, 2.29):asc\") and fail if they try. Remove sort = false if the field is used for geo sorting.",
+			fm.Name.ValueString(), fm.Type.ValueString(), fm.Name.ValueString()),
+	)
+}
@@ -0,0 +1,358 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestVecDistValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{name: "cosine", value: types.StringValue("cosine")},
+		{name: "inner product", value: types.StringValue("ip")},
+		{name: "l2 is unsupported", value: types.StringValue("l2"), wantError: true},
+		{name: "typo", value: types.StringValue("cosin"), wantError: true},
+		{name: "wrong case", value: types.StringValue("COSINE"), wantError: true},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("vec_dist"), ConfigValue: tt.value}
+			var resp validator.StringResponse
+
+			vecDistValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Fatalf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCollectionValidateConfigVecDistRequiresVectorField(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     map[string]attr.Value
+		wantError bool
+	}{
+		{
+			name:  "vector field",
+			field: map[string]attr.Value{"type": types.StringValue("float[]"), "num_dim": types.Int64Value(384), "vec_dist": types.StringValue("ip")},
+		},
+		{
+			name:      "vec_dist without num_dim",
+			field:     map[string]attr.Value{"type": types.StringValue("float[]"), "vec_dist": types.StringValue("cosine")},
+			wantError: true,
+		},
+		{
+			name:      "vec_dist with zero num_dim",
+			field:     map[string]attr.Value{"type": types.StringValue("float[]"), "num_dim": types.Int64Value(0), "vec_dist": types.StringValue("cosine")},
+			wantError: true,
+		},
+		{
+			name: "embedding field",
+			field: map[string]attr.Value{
+				"type":     types.StringValue("float[]"),
+				"vec_dist": types.StringValue("cosine"),
+				"embed": types.ObjectValueMust(embedAttrTypes, map[string]attr.Value{
+					"from":         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("title")}),
+					"model_config": types.ObjectNull(embedModelConfigAttrTypes),
+				}),
+			},
+		},
+		{
+			name:  "plain field",
+			field: map[string]attr.Value{"type": types.StringValue("string")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			config := collectionConfigWithField(t, r, tt.field)
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Fatalf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// collectionConfigWithField builds a collection config with a single field
+// named "embedding"; attributes not given in overrides are null.
+func collectionConfigWithField(t *testing.T, r *CollectionResource, overrides map[string]attr.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	fieldValues := map[string]attr.Value{}
+	for name, typ := range fieldAttrTypes() {
+		null, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+		if err != nil {
+			t.Fatalf("failed to build null %s: %v", name, err)
+		}
+		fieldValues[name] = null
+	}
+	fieldValues["name"] = types.StringValue("embedding")
+	for name, v := range overrides {
+		fieldValues[name] = v
+	}
+
+	field := types.ObjectValueMust(fieldAttrTypes(), fieldValues)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.SetAttribute(ctx, path.Root("name"), "products")
+	diags.Append(plan.SetAttribute(ctx, path.Root("field"), types.ListValueMust(types.ObjectType{AttrTypes: fieldAttrTypes()}, []attr.Value{field}))...)
+	if diags.HasError() {
+		t.Fatalf("failed to build config: %v", diags)
+	}
+
+	return tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+}
+
+func TestCollectionValidateConfigRejectsSearchOptionsOnNonIndexedField(t *testing.T) {
+	tests := []struct {
+		name      string
+		field     map[string]attr.Value
+		wantError string
+	}{
+		{
+			name:  "store-only field",
+			field: map[string]attr.Value{"type": types.StringValue("string"), "index": types.BoolValue(false), "store": types.BoolValue(false)},
+		},
+		{
+			name:      "facet on non-indexed field",
+			field:     map[string]attr.Value{"type": types.StringValue("string"), "index": types.BoolValue(false), "facet": types.BoolValue(true)},
+			wantError: "facet",
+		},
+		{
+			name:      "sort on non-indexed field",
+			field:     map[string]attr.Value{"type": types.StringValue("int32"), "index": types.BoolValue(false), "sort": types.BoolValue(true)},
+			wantError: "sort",
+		},
+		{
+			name:      "infix on non-indexed field",
+			field:     map[string]attr.Value{"type": types.StringValue("string"), "index": types.BoolValue(false), "infix": types.BoolValue(true)},
+			wantError: "infix",
+		},
+		{
+			name:  "facet on indexed field",
+			field: map[string]attr.Value{"type": types.StringValue("string"), "index": types.BoolValue(true), "facet": types.BoolValue(true)},
+		},
+		{
+			name:  "explicit false options on non-indexed field",
+			field: map[string]attr.Value{"type": types.StringValue("string"), "index": types.BoolValue(false), "facet": types.BoolValue(false)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			config := collectionConfigWithField(t, r, tt.field)
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+
+			errs := resp.Diagnostics.Errors()
+			if tt.wantError == "" {
+				if len(errs) != 0 {
+					t.Fatalf("got errors %v, want none", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("got %d errors, want 1: %v", len(errs), errs)
+			}
+			if !strings.Contains(errs[0].Detail(), tt.wantError+" = true") || !strings.Contains(errs[0].Detail(), "store-only") {
+				t.Errorf("error detail = %q, want it to name %s and explain store-only fields", errs[0].Detail(), tt.wantError)
+			}
+		})
+	}
+}
+
+func TestCollectionValidateConfigWarnsOnOptionalDefaultSortingField(t *testing.T) {
+	tests := []struct {
+		name                string
+		defaultSortingField string
+		optional            types.Bool
+		wantWarning         bool
+		wantError           bool
+	}{
+		{name: "optional default sorting field", defaultSortingField: "embedding", optional: types.BoolValue(true), wantWarning: true},
+		{name: "required default sorting field", defaultSortingField: "embedding", optional: types.BoolValue(false)},
+		{name: "optional unset", defaultSortingField: "embedding", optional: types.BoolNull()},
+		{name: "optional field is not the default sorting field", defaultSortingField: "rating", optional: types.BoolValue(true), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			config := collectionConfigWithField(t, r, map[string]attr.Value{"type": types.StringValue("int32"), "optional": tt.optional})
+			plan := tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}
+			if diags := plan.SetAttribute(ctx, path.Root("default_sorting_field"), tt.defaultSortingField); diags.HasError() {
+				t.Fatalf("failed to set default_sorting_field: %v", diags)
+			}
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Fatalf("HasError() = %v, want %v: %v", got, tt.wantError, resp.Diagnostics.Errors())
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Fatalf("warning = %v, want %v: %v", got, tt.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCollectionValidateConfigDefaultSortingFieldMustBeSortable(t *testing.T) {
+	tests := []struct {
+		name                string
+		defaultSortingField string
+		field               map[string]attr.Value
+		wantError           string
+	}{
+		{name: "int32 field", defaultSortingField: "embedding", field: map[string]attr.Value{"type": types.StringValue("int32")}},
+		{name: "int64 field", defaultSortingField: "embedding", field: map[string]attr.Value{"type": types.StringValue("int64")}},
+		{name: "float field", defaultSortingField: "embedding", field: map[string]attr.Value{"type": types.StringValue("float")}},
+		{name: "string field with sort", defaultSortingField: "embedding", field: map[string]attr.Value{"type": types.StringValue("string"), "sort": types.BoolValue(true)}},
+		{name: "string field", defaultSortingField: "embedding", field: map[string]attr.Value{"type": types.StringValue("string")}, wantError: "Default Sorting Field Not Sortable"},
+		{name: "numeric field with sort disabled", defaultSortingField: "embedding", field: map[string]attr.Value{"type": types.StringValue("float"), "sort": types.BoolValue(false)}, wantError: "Default Sorting Field Not Sortable"},
+		{name: "numeric array field", defaultSortingField: "embedding", field: map[string]attr.Value{"type": types.StringValue("int32[]")}, wantError: "Default Sorting Field Not Sortable"},
+		{name: "undeclared field", defaultSortingField: "popularity", field: map[string]attr.Value{"type": types.StringValue("int32")}, wantError: "Unknown Default Sorting Field"},
+		{name: "unknown field type", defaultSortingField: "embedding", field: map[string]attr.Value{"type": types.StringUnknown()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			config := collectionConfigWithField(t, r, tt.field)
+			plan := tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}
+			if diags := plan.SetAttribute(ctx, path.Root("default_sorting_field"), tt.defaultSortingField); diags.HasError() {
+				t.Fatalf("failed to set default_sorting_field: %v", diags)
+			}
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+
+			errs := resp.Diagnostics.Errors()
+			if tt.wantError == "" {
+				if len(errs) != 0 {
+					t.Fatalf("got errors %v, want none", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Summary() != tt.wantError {
+				t.Fatalf("got errors %v, want one %q", errs, tt.wantError)
+			}
+			if withPath, ok := errs[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root("default_sorting_field")) {
+				t.Errorf("error is not reported against default_sorting_field: %v", errs[0])
+			}
+		})
+	}
+}
+
+func TestCollectionValidateConfigRequiresAField(t *testing.T) {
+	ctx := context.Background()
+	r := &CollectionResource{}
+
+	t.Run("no field blocks", func(t *testing.T) {
+		plan := collectionPlanWithFields(t, r, nil)
+
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+
+		errs := resp.Diagnostics.Errors()
+		if len(errs) != 1 || errs[0].Summary() != "Missing Collection Fields" {
+			t.Fatalf("got errors %v, want Missing Collection Fields", errs)
+		}
+		if !strings.Contains(errs[0].Detail(), `"id"`) {
+			t.Errorf("error detail = %q, want it to suggest an id field", errs[0].Detail())
+		}
+	})
+
+	t.Run("only the id field", func(t *testing.T) {
+		plan := collectionPlanWithFields(t, r, []string{"id"})
+
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+		}
+	})
+
+	t.Run("schema_from without field blocks", func(t *testing.T) {
+		plan := collectionPlanWithFields(t, r, nil)
+		if diags := plan.SetAttribute(ctx, path.Root("schema_from"), "products"); diags.HasError() {
+			t.Fatalf("failed to set schema_from: %v", diags)
+		}
+
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+		}
+	})
+}
+
+func TestCollectionValidateConfigWarnsOnUnsortableGeopoint(t *testing.T) {
+	tests := []struct {
+		name        string
+		fieldType   string
+		sort        types.Bool
+		wantWarning bool
+	}{
+		{name: "geopoint with sort = false", fieldType: "geopoint", sort: types.BoolValue(false), wantWarning: true},
+		{name: "geopoint array with sort = false", fieldType: "geopoint[]", sort: types.BoolValue(false), wantWarning: true},
+		{name: "geopoint with default sort", fieldType: "geopoint", sort: types.BoolNull()},
+		{name: "geopoint with sort = true", fieldType: "geopoint", sort: types.BoolValue(true)},
+		{name: "string with sort = false", fieldType: "string", sort: types.BoolValue(false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &CollectionResource{}
+			config := collectionConfigWithField(t, r, map[string]attr.Value{"type": types.StringValue(tt.fieldType), "sort": tt.sort})
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Fatalf("warning = %v, want %v: %v", got, tt.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ConfigResource{}
+var _ resource.ResourceWithImportState = &ConfigResource{}
+
+// NewConfigResource creates a new runtime config resource
+func NewConfigResource() resource.Resource {
+	return &ConfigResource{}
+}
+
+// ConfigResource manages the runtime settings of the configured Typesense
+// server through its /config endpoint. There is one per server, so the id is
+// the server host.
+type ConfigResource struct {
+	client *client.ServerClient
+}
+
+// ConfigResourceModel describes the resource data model.
+type ConfigResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	LogSlowRequestsTimeMs types.Int64  `tfsdk:"log_slow_requests_time_ms"`
+	CacheNumEntries       types.Int64  `tfsdk:"cache_num_entries"`
+	HealthyReadLag        types.Int64  `tfsdk:"healthy_read_lag"`
+	HealthyWriteLag       types.Int64  `tfsdk:"healthy_write_lag"`
+	SkipWrites            types.Bool   `tfsdk:"skip_writes"`
+}
+
+func (r *ConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.ResourceConfig)
+}
+
+func (r *ConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages runtime settings of the Typesense server via its /config endpoint. " +
+			"Only settings that are set are sent; the others keep their current value. " +
+			"Runtime settings are not persisted by Typesense: a restarted node goes back to its command line flags or configuration file until the next apply. " +
+			"Destroying the resource leaves the settings as they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The host of the Typesense server the settings apply to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_slow_requests_time_ms": schema.Int64Attribute{
+				Description: "Log requests that take longer than this many milliseconds. -1 disables slow request logging.",
+				Optional:    true,
+			},
+			"cache_num_entries": schema.Int64Attribute{
+				Description: "Number of entries in the search result cache.",
+				Optional:    true,
+			},
+			"healthy_read_lag": schema.Int64Attribute{
+				Description: "Number of pending writes a replica may lag behind before it stops serving reads.",
+				Optional:    true,
+			},
+			"healthy_write_lag": schema.Int64Attribute{
+				Description: "Number of pending writes a node may lag behind before it stops accepting writes.",
+				Optional:    true,
+			},
+			"skip_writes": schema.BoolAttribute{
+				Description: "When true, the server skips applying writes, e.g. while recovering a node.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to manage runtime config.",
+		)
+		return
+	}
+
+	r.client = providerData.ServerClient
+}
+
+func (r *ConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.UpdateConfig(ctx, configFromModel(&data)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update runtime config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(r.client.Host())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read runtime config: %s", err))
+		return
+	}
+
+	// Servers that cannot report their settings keep the last applied values.
+	if config != nil {
+		updateModelFromConfig(&data, config)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.UpdateConfig(ctx, configFromModel(&data)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update runtime config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(r.client.Host())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state. Typesense has no way to reset
+// runtime settings to their startup values; restarting the node does that.
+func (r *ConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *ConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// configFromModel builds a /config update with only the configured settings.
+func configFromModel(data *ConfigResourceModel) *client.ServerConfig {
+	config := &client.ServerConfig{}
+	if !data.LogSlowRequestsTimeMs.IsNull() && !data.LogSlowRequestsTimeMs.IsUnknown() {
+		config.LogSlowRequestsTimeMs = data.LogSlowRequestsTimeMs.ValueInt64Pointer()
+	}
+	if !data.CacheNumEntries.IsNull() && !data.CacheNumEntries.IsUnknown() {
+		config.CacheNumEntries = data.CacheNumEntries.ValueInt64Pointer()
+	}
+	if !data.HealthyReadLag.IsNull() && !data.HealthyReadLag.IsUnknown() {
+		config.HealthyReadLag = data.HealthyReadLag.ValueInt64Pointer()
+	}
+	if !data.HealthyWriteLag.IsNull() && !data.HealthyWriteLag.IsUnknown() {
+		config.HealthyWriteLag = data.HealthyWriteLag.ValueInt64Pointer()
+	}
+	if !data.SkipWrites.IsNull() && !data.SkipWrites.IsUnknown() {
+		config.SkipWrites = data.SkipWrites.ValueBoolPointer()
+	}
+	return config
+}
+
+// updateModelFromConfig refreshes the managed settings from the server.
+// Settings left unset in configuration stay null.
+func updateModelFromConfig(data *ConfigResourceModel, config *client.ServerConfig) {
+	refreshInt64 := func(current types.Int64, server *int64) types.Int64 {
+		if current.IsNull() || server == nil {
+			return current
+		}
+		return types.Int64Value(*server)
+	}
+
+	data.LogSlowRequestsTimeMs = refreshInt64(data.LogSlowRequestsTimeMs, config.LogSlowRequestsTimeMs)
+	data.CacheNumEntries = refreshInt64(data.CacheNumEntries, config.CacheNumEntries)
+	data.HealthyReadLag = refreshInt64(data.HealthyReadLag, config.HealthyReadLag)
+	data.HealthyWriteLag = refreshInt64(data.HealthyWriteLag, config.HealthyWriteLag)
+	if !data.SkipWrites.IsNull() && config.SkipWrites != nil {
+		data.SkipWrites = types.BoolValue(*config.SkipWrites)
+	}
+}
@@ -0,0 +1,42 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigResourceConfig(2000, 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("typesense_config.test", "id"),
+					resource.TestCheckResourceAttr("typesense_config.test", "log_slow_requests_time_ms", "2000"),
+					resource.TestCheckResourceAttr("typesense_config.test", "cache_num_entries", "1000"),
+				),
+			},
+			{
+				Config: testAccConfigResourceConfig(-1, 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_config.test", "log_slow_requests_time_ms", "-1"),
+					resource.TestCheckResourceAttr("typesense_config.test", "cache_num_entries", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigResourceConfig(logSlowRequestsTimeMs, cacheNumEntries int) string {
+	return fmt.Sprintf(`
+resource "typesense_config" "test" {
+  log_slow_requests_time_ms = %d
+  cache_num_entries         = %d
+}
+`, logSlowRequestsTimeMs, cacheNumEntries)
+}
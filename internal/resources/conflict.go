@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// isConflictError reports whether a client error was caused by an HTTP 409
+// Conflict response, i.e. the object already exists on the server.
+func isConflictError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "status 409")
+}
+
+// alreadyExistsError is returned when a create was rejected with 409 Conflict
+// and the object turned out to exist. Terraform does not manage that object,
+// so it is left as it is and the user is asked to import it.
+type alreadyExistsError struct {
+	importID string
+	err      error
+}
+
+func (e *alreadyExistsError) Error() string {
+	return fmt.Sprintf("it already exists on the server and is not managed by Terraform; import it with ID %q instead of creating it (%s)", e.importID, e.err)
+}
+
+func (e *alreadyExistsError) Unwrap() error {
+	return e.err
+}
+
+// isAlreadyExists reports whether err, or any error it wraps, is an
+// alreadyExistsError.
+func isAlreadyExists(err error) bool {
+	var existsErr *alreadyExistsError
+	return errors.As(err, &existsErr)
+}
+
+// createOrReportExisting runs create for the PUT-based resources (synonyms,
+// overrides, stopwords sets, presets), which normally upsert. If a proxy or a
+// concurrent writer still surfaces a 409 Conflict and exists confirms the
+// object is there, update applies the plan over it when the resource sets
+// upsert_on_conflict; update is nil otherwise. Without it the object is not
+// written over: the error names importID so the user can bring it under
+// Terraform instead.
+func createOrReportExisting(create func() error, exists func() (bool, error), update func() error, importID string) error {
+	err := create()
+	if !isConflictError(err) {
+		return err
+	}
+
+	found, getErr := exists()
+	if getErr != nil {
+		return fmt.Errorf("object already exists but failed to read it: %w", getErr)
+	}
+	if !found {
+		return err
+	}
+
+	if update != nil {
+		if updateErr := update(); updateErr != nil {
+			return fmt.Errorf("object already exists and upsert_on_conflict failed to update it: %w", updateErr)
+		}
+		return nil
+	}
+
+	return &alreadyExistsError{importID: importID, err: err}
+}
+
+// upsertOnConflict returns the update createOrReportExisting applies to an
+// existing object when upsert_on_conflict is set, and nil otherwise. Update
+// writes these resources with the same PUT as Create, so callers pass their
+// create function.
+func upsertOnConflict(enabled types.Bool, update func() error) func() error {
+	if !enabled.ValueBool() {
+		return nil
+	}
+	return update
+}
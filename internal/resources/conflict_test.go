@@ -0,0 +1,156 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCreateOrReportExistingAsksToImport(t *testing.T) {
+	conflict := errors.New("failed to create preset: status 409, body: {\"message\": \"already exists\"}")
+	calls := 0
+	create := func() error {
+		calls++
+		return conflict
+	}
+	exists := func() (bool, error) { return true, nil }
+
+	err := createOrReportExisting(create, exists, nil, "listing")
+	if !isAlreadyExists(err) || !errors.Is(err, conflict) {
+		t.Fatalf("createOrReportExisting returned %v, want an already-exists error wrapping the conflict", err)
+	}
+	if !strings.Contains(err.Error(), `import it with ID "listing"`) {
+		t.Errorf("error = %q, want the import ID", err)
+	}
+	if calls != 1 {
+		t.Errorf("create called %d times, want 1: the existing object must not be written over", calls)
+	}
+	if got := clientErrorSummary(err); got != "Object Already Exists" {
+		t.Errorf("clientErrorSummary = %q, want Object Already Exists", got)
+	}
+}
+
+func TestCreateOrReportExistingReturnsConflictWhenObjectMissing(t *testing.T) {
+	conflict := errors.New("failed to create stopwords: status 409, body: {}")
+
+	err := createOrReportExisting(
+		func() error { return conflict },
+		func() (bool, error) { return false, nil },
+		nil,
+		"common",
+	)
+	if err != conflict {
+		t.Errorf("createOrReportExisting returned %v, want original conflict error", err)
+	}
+}
+
+func TestCreateOrReportExistingPassesThroughOtherErrors(t *testing.T) {
+	badRequest := errors.New("failed to create synonym: status 400, body: {}")
+
+	err := createOrReportExisting(
+		func() error { return badRequest },
+		func() (bool, error) { t.Error("exists should not be called"); return false, nil },
+		nil,
+		"products/clothing",
+	)
+	if err != badRequest {
+		t.Errorf("createOrReportExisting returned %v, want %v", err, badRequest)
+	}
+}
+
+// conflictingStopwordsServer answers the first PUT of the "common" stopwords
+// set with 409 Conflict, as a proxy or concurrent writer might, and serves the
+// set as already existing. It counts the PUTs it receives.
+func conflictingStopwordsServer(t *testing.T, puts *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/stopwords/common" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"stopwords": {"id": "common", "stopwords": ["a"]}}`))
+		case http.MethodPut:
+			*puts++
+			if *puts == 1 {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"message": "already exists"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id": "common", "stopwords": ["the", "and"]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func createStopwordsSetWith(t *testing.T, handler http.Handler, upsert bool) resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+	r := &StopwordsSetResource{
+		client:         newTestServerClient(t, handler),
+		featureChecker: version.NewFeatureChecker(version.MustParse("29.0")),
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
+	diags.Append(plan.SetAttribute(ctx, path.Root("name"), "common")...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("stopwords"), []string{"the", "and"})...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("upsert_on_conflict"), upsert)...)
+	if diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	return resp
+}
+
+func TestStopwordsSetCreateUpsertsOnConflict(t *testing.T) {
+	puts := 0
+	resp := createStopwordsSetWith(t, conflictingStopwordsServer(t, &puts), true)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+	if puts != 2 {
+		t.Errorf("PUTs = %d, want 2: the conflicting create and the update of the existing set", puts)
+	}
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+	if id.ValueString() != "common" {
+		t.Errorf("state id = %s, want common", id)
+	}
+}
+
+func TestStopwordsSetCreateReportsConflictWithoutUpsert(t *testing.T) {
+	puts := 0
+	resp := createStopwordsSetWith(t, conflictingStopwordsServer(t, &puts), false)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 || errs[0].Summary() != "Object Already Exists" {
+		t.Fatalf("got diagnostics %v, want a single Object Already Exists error", resp.Diagnostics)
+	}
+	if !strings.Contains(errs[0].Detail(), `import it with ID "common"`) {
+		t.Errorf("error detail = %q, want the import ID", errs[0].Detail())
+	}
+	if puts != 1 {
+		t.Errorf("PUTs = %d, want 1: the existing set must not be written over", puts)
+	}
+}
@@ -68,7 +68,7 @@ func (r *ConversationModelResource) Schema(ctx context.Context, req resource.Sch
 				Required:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "API key for authenticating with the LLM provider (OpenAI, Cloudflare, etc.).",
+				Description: "API key for authenticating with the LLM provider (OpenAI, Cloudflare, etc.). It cannot be read back, so it is empty after an import until the configuration supplies it again.",
 				Required:    true,
 				Sensitive:   true,
 			},
@@ -223,6 +223,9 @@ func (r *ConversationModelResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+// ImportState imports a model by id. The server does not return a usable
+// api_key, so it stays null; Read fills in the other attributes, and the next
+// plan shows the api_key from the configuration as an update.
 func (r *ConversationModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
@@ -259,27 +262,31 @@ func (r *ConversationModelResource) buildConversationModel(data *ConversationMod
 	return model
 }
 
-// updateModelFromResponse updates the Terraform resource model from the API response
+// updateModelFromResponse updates the Terraform resource model from the API
+// response, so drift in ttl, max_bytes, history_collection and system_prompt
+// shows up on refresh. api_key and account_id are secrets and always keep
+// their state value, even when the server echoes them back. max_bytes and
+// vllm_url are only refreshed when set in state or on import, so a server
+// default does not show up as a change to an unset attribute.
 func (r *ConversationModelResource) updateModelFromResponse(data *ConversationModelResourceModel, model *client.ConversationModel) {
+	// An imported resource has only its id in state.
+	importing := data.ModelName.IsNull()
+
 	data.ID = types.StringValue(model.ID)
 	data.ModelName = types.StringValue(model.ModelName)
 	data.HistoryCollection = types.StringValue(model.HistoryCollection)
 	data.SystemPrompt = types.StringValue(model.SystemPrompt)
-	// API key is not returned by the API for security, keep the state value
 
+	// The server normalizes ttl and fills in its default when unset.
 	if model.TTL != 0 {
 		data.TTL = types.Int64Value(model.TTL)
 	}
 
-	if model.MaxBytes != 0 {
+	if (importing || !data.MaxBytes.IsNull()) && model.MaxBytes != 0 {
 		data.MaxBytes = types.Int64Value(model.MaxBytes)
 	}
 
-	if model.AccountID != "" {
-		data.AccountID = types.StringValue(model.AccountID)
-	}
-
-	if model.VllmURL != "" {
+	if (importing || !data.VllmURL.IsNull()) && model.VllmURL != "" {
 		data.VllmURL = types.StringValue(model.VllmURL)
 	}
 }
@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func conversationModelState(t *testing.T, r *ConversationModelResource, data ConversationModelResourceModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+	return state
+}
+
+func TestConversationModelReadReconcilesDrift(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/conversations/models/support" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"id": "support",
+			"model_name": "cf/meta/llama-3-8b-instruct",
+			"api_key": "sk-server-copy",
+			"account_id": "acct-server-copy",
+			"history_collection": "conversations_v2",
+			"system_prompt": "Changed outside Terraform",
+			"ttl": 3600,
+			"max_bytes": 32768
+		}`))
+	})
+
+	ctx := context.Background()
+	r := &ConversationModelResource{client: newTestServerClient(t, handler)}
+	state := conversationModelState(t, r, ConversationModelResourceModel{
+		ID:                types.StringValue("support"),
+		ModelName:         types.StringValue("cf/meta/llama-3-8b-instruct"),
+		APIKey:            types.StringValue("sk-config"),
+		HistoryCollection: types.StringValue("conversations"),
+		SystemPrompt:      types.StringValue("You are helpful."),
+		TTL:               types.Int64Value(86400),
+		MaxBytes:          types.Int64Value(16384),
+		AccountID:         types.StringValue("acct-config"),
+		VllmURL:           types.StringNull(),
+	})
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+
+	var got ConversationModelResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.TTL.ValueInt64() != 3600 || got.MaxBytes.ValueInt64() != 32768 {
+		t.Errorf("ttl = %s, max_bytes = %s, want the server's 3600 and 32768", got.TTL, got.MaxBytes)
+	}
+	if got.HistoryCollection.ValueString() != "conversations_v2" || got.SystemPrompt.ValueString() != "Changed outside Terraform" {
+		t.Errorf("history_collection = %s, system_prompt = %s, want the server's values", got.HistoryCollection, got.SystemPrompt)
+	}
+	if got.APIKey.ValueString() != "sk-config" || got.AccountID.ValueString() != "acct-config" {
+		t.Errorf("api_key = %s, account_id = %s, want the state values kept", got.APIKey, got.AccountID)
+	}
+}
+
+func TestConversationModelReadRemovesMissingModel(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	r := &ConversationModelResource{client: newTestServerClient(t, handler)}
+	state := conversationModelState(t, r, ConversationModelResourceModel{
+		ID:                types.StringValue("support"),
+		ModelName:         types.StringValue("openai/gpt-4o"),
+		APIKey:            types.StringValue("sk-config"),
+		HistoryCollection: types.StringValue("conversations"),
+		SystemPrompt:      types.StringValue("You are helpful."),
+		TTL:               types.Int64Value(86400),
+		MaxBytes:          types.Int64Null(),
+		AccountID:         types.StringNull(),
+		VllmURL:           types.StringNull(),
+	})
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("Read kept a conversation model the server no longer has")
+	}
+}
+
+func TestConversationModelImportLeavesAPIKeyUnset(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "support",
+			"model_name": "openai/gpt-4o",
+			"api_key": "sk-****",
+			"history_collection": "conversations",
+			"system_prompt": "You are helpful.",
+			"ttl": 86400
+		}`))
+	})
+
+	ctx := context.Background()
+	r := &ConversationModelResource{client: newTestServerClient(t, handler)}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	importResp := resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "support"}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("ImportState returned errors: %v", importResp.Diagnostics)
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+
+	var got ConversationModelResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &got)...)
+	if got.ModelName.ValueString() != "openai/gpt-4o" || got.HistoryCollection.ValueString() != "conversations" {
+		t.Errorf("model_name = %s, history_collection = %s, want the server's values", got.ModelName, got.HistoryCollection)
+	}
+	if !got.APIKey.IsNull() {
+		t.Errorf("api_key = %s, want null after import", got.APIKey)
+	}
+}
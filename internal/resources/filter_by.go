@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// joinReference matches the "$collection(" opening of a join filter such as
+// "$authors(name:John)", whose body is itself a filter expression.
+var joinReference = regexp.MustCompile(`\$[A-Za-z0-9_\-]+\(`)
+
+// parenthesizedValue matches a value list in parentheses directly after a
+// field's ":", such as the geo filter "location:(48.85, 2.29, 5 km)" or a
+// polygon. Its commas and colons are not part of the expression's structure.
+var parenthesizedValue = regexp.MustCompile(`:\s*\([^()]*\)`)
+
+// malformedFilterOperators are comparisons that look plausible but that
+// Typesense does not accept after the field's ":". Typesense uses :=, :!=,
+// :>, :>=, :<, :<= and :[a..b].
+var malformedFilterOperators = []string{"==", "=>", "=<", "<>", "!==", "><"}
+
+// filterByProblem returns a description of the first obvious mistake in a
+// filter_by expression, or "" if it found none. It is not a parser: it only
+// catches unbalanced parentheses, clauses without a ":" and a few malformed
+// operators. Parenthesized geo values are taken as a whole. The server remains the final judge of the expression.
+func filterByProblem(expr string) string {
+	expr = stripBacktickQuotes(expr)
+	if strings.Count(expr, "`")%2 != 0 {
+		return "it has an unterminated backtick-quoted value"
+	}
+
+	depth := 0
+	for _, c := range expr {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return "it closes a parenthesis that was never opened"
+			}
+		}
+	}
+	if depth > 0 {
+		return "it has an unclosed parenthesis"
+	}
+
+	trimmed := strings.TrimSpace(expr)
+	for _, op := range []string{"&&", "||"} {
+		if strings.HasPrefix(trimmed, op) || strings.HasSuffix(trimmed, op) {
+			return fmt.Sprintf("it starts or ends with %q", op)
+		}
+	}
+
+	grouped := parenthesizedValue.ReplaceAllString(expr, ":x")
+	grouped = joinReference.ReplaceAllString(grouped, "(")
+	grouped = strings.NewReplacer("(", "&&", ")", "&&", "||", "&&").Replace(grouped)
+	for _, clause := range strings.Split(grouped, "&&") {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			continue
+		}
+		field, value, ok := strings.Cut(clause, ":")
+		if !ok || strings.TrimSpace(field) == "" {
+			return fmt.Sprintf("the clause %q is not of the form field:value", clause)
+		}
+		value = strings.TrimSpace(value)
+		for _, op := range malformedFilterOperators {
+			if strings.HasPrefix(value, op) {
+				return fmt.Sprintf("the clause %q uses the unknown operator %q", clause, op)
+			}
+		}
+	}
+	return ""
+}
+
+// stripBacktickQuotes blanks out backtick-quoted values, which may contain
+// parentheses, colons or && that are not part of the expression's structure.
+// An unterminated quote is left in place.
+func stripBacktickQuotes(expr string) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(expr, "`")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(expr[start+1:], "`")
+		if end < 0 {
+			break
+		}
+		b.WriteString(expr[:start])
+		b.WriteString("x")
+		expr = expr[start+1+end+1:]
+	}
+	b.WriteString(expr)
+	return b.String()
+}
+
+// filterByValidator warns about obvious mistakes in a filter_by expression
+// during plan, instead of waiting for the server's 400 at apply. It only
+// warns, since the check is not a full parser.
+type filterByValidator struct{}
+
+var _ validator.String = filterByValidator{}
+
+func (v filterByValidator) Description(ctx context.Context) string {
+	return "value should be a well-formed Typesense filter_by expression"
+}
+
+func (v filterByValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v filterByValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if problem := filterByProblem(req.ConfigValue.ValueString()); problem != "" {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Possibly Invalid filter_by",
+			fmt.Sprintf("The filter_by expression %q looks malformed: %s. Typesense will reject it at apply if it is.", req.ConfigValue.ValueString(), problem),
+		)
+	}
+}
+
+// presetFilterByValidator applies the filter_by check to the filter_by search
+// parameters inside a preset's JSON value: the top-level one, and those of
+// each entry of a multi-search "searches" list. Values that are not valid JSON
+// are left to the server.
+type presetFilterByValidator struct{}
+
+var _ validator.String = presetFilterByValidator{}
+
+func (v presetFilterByValidator) Description(ctx context.Context) string {
+	return "filter_by search parameters should be well-formed Typesense filter_by expressions"
+}
+
+func (v presetFilterByValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v presetFilterByValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	type searchParams struct {
+		FilterBy any `json:"filter_by"`
+	}
+	var value struct {
+		searchParams
+		Searches []searchParams `json:"searches"`
+	}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &value); err != nil {
+		return
+	}
+
+	for _, params := range append([]searchParams{value.searchParams}, value.Searches...) {
+		expr, ok := params.FilterBy.(string)
+		if !ok {
+			continue
+		}
+		if problem := filterByProblem(expr); problem != "" {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path,
+				"Possibly Invalid filter_by",
+				fmt.Sprintf("The preset's filter_by expression %q looks malformed: %s. Typesense will reject searches using the preset if it is.", expr, problem),
+			)
+		}
+	}
+}
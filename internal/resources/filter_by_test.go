@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFilterByProblem(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{expr: "price:>10 && (brand:Nike || brand:Adidas)"},
+		{expr: "category:=[`Shoes (Men)`, Sandals] && stock:[1..100]"},
+		{expr: "$authors(name:John && country:=US) && year:>2000"},
+		{expr: "title:`a && b:c`"},
+		{expr: "location:(48.85, 2.29, 5 km) && price:>10"},
+		{expr: "location:(48.85, 2.29, 5 km, exact_filter_radius: 3 km)"},
+		{expr: "location:(48.8662, 2.3255, 48.8581, 2.3209, 48.8561, 2.3448, 48.8641, 2.3469)"},
+		{expr: "(location: (48.85, 2.29, 5 km) || city:Paris) && price:>10"},
+		{expr: "location:(48.85, 2.29, 5 km", wantErr: true},
+		{expr: "price:>10 && (brand:Nike", wantErr: true},
+		{expr: "price:>10) && brand:Nike", wantErr: true},
+		{expr: "price>10", wantErr: true},
+		{expr: "brand:==Nike", wantErr: true},
+		{expr: "price:=>10", wantErr: true},
+		{expr: "price:>10 &&", wantErr: true},
+		{expr: "title:`unterminated", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		problem := filterByProblem(tt.expr)
+		if (problem != "") != tt.wantErr {
+			t.Errorf("filterByProblem(%q) = %q, want a problem: %v", tt.expr, problem, tt.wantErr)
+		}
+	}
+}
+
+func TestFilterByValidatorsOnlyWarn(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     string
+		warnings  int
+	}{
+		{name: "override", validator: filterByValidator{}, value: "brand:Nike || (price:>10", warnings: 1},
+		{name: "override valid", validator: filterByValidator{}, value: "brand:Nike"},
+		{name: "preset", validator: presetFilterByValidator{}, value: `{"q": "*", "filter_by": "brand==Nike"}`, warnings: 1},
+		{name: "preset searches", validator: presetFilterByValidator{}, value: `{"searches": [{"filter_by": "price:>1"}, {"filter_by": "(a:1"}]}`, warnings: 1},
+		{name: "preset invalid json", validator: presetFilterByValidator{}, value: `{"filter_by": `},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("filter_by"), ConfigValue: types.StringValue(tt.value)}
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tt.warnings {
+				t.Errorf("warnings = %d (%v), want %d", got, resp.Diagnostics, tt.warnings)
+			}
+		})
+	}
+}
@@ -67,10 +67,12 @@ func (r *NLSearchModelResource) Schema(ctx context.Context, req resource.SchemaR
 			"automatically converted to 'filter_by: color:=red && price:<50'.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier for the NL search model. This ID is used to reference the model in search queries via the nl_model_id parameter.",
-				Required:    true,
+				Description: "Unique identifier for the NL search model. This ID is used to reference the model in search queries via the nl_model_id parameter. If omitted, the server generates one.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"model_name": schema.StringAttribute{
@@ -208,7 +210,7 @@ func (r *NLSearchModelResource) Create(ctx context.Context, req resource.CreateR
 
 	created, err := r.client.CreateNLSearchModel(ctx, model)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NL search model: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create NL search model: %s", err))
 		return
 	}
 
@@ -372,8 +374,12 @@ func (r *NLSearchModelResource) updateModelFromResponse(data *NLSearchModelResou
 	data.ModelName = types.StringValue(model.ModelName)
 	// API key is not returned by the API for security, keep the state value
 
+	// Non-secret fields are reconciled against the server so out-of-band
+	// changes (including clearing system_prompt) show up as drift.
 	if model.SystemPrompt != "" {
 		data.SystemPrompt = types.StringValue(model.SystemPrompt)
+	} else {
+		data.SystemPrompt = types.StringNull()
 	}
 
 	if model.MaxBytes != 0 {
@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNLSearchModelCreateStoresServerGeneratedID(t *testing.T) {
+	var posted map[string]any
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/nl_search_models" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&posted); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "a1b2c3", "model_name": "openai/gpt-4o-mini", "max_bytes": 16000}`))
+	})
+
+	ctx := context.Background()
+	serverVersion := version.MustParse("29.0")
+	r := &NLSearchModelResource{
+		client:         newTestServerClient(t, handler),
+		featureChecker: version.NewFeatureChecker(serverVersion),
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
+	diags.Append(plan.SetAttribute(ctx, path.Root("model_name"), "openai/gpt-4o-mini")...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("api_key"), "sk-test")...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("max_bytes"), int64(16000))...)
+	if diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	if _, sent := posted["id"]; sent {
+		t.Errorf("POST body included id %v, want it omitted so the server generates one", posted["id"])
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if id.ValueString() != "a1b2c3" {
+		t.Errorf("state id = %s, want the server-generated a1b2c3", id)
+	}
+}
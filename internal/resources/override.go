@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -38,6 +39,7 @@ func NewOverrideResource() resource.Resource {
 type OverrideResource struct {
 	client         *client.ServerClient
 	featureChecker version.FeatureChecker
+	prefix         collectionNamePrefix
 }
 
 // OverrideResourceModel describes the resource data model.
@@ -56,6 +58,7 @@ type OverrideResourceModel struct {
 	EffectiveFromTs     types.Int64  `tfsdk:"effective_from_ts"`
 	EffectiveToTs       types.Int64  `tfsdk:"effective_to_ts"`
 	StopProcessing      types.Bool   `tfsdk:"stop_processing"`
+	UpsertOnConflict    types.Bool   `tfsdk:"upsert_on_conflict"`
 }
 
 // OverrideRuleModel describes the rule block
@@ -85,14 +88,14 @@ func (r *OverrideResource) Schema(ctx context.Context, req resource.SchemaReques
 		Description: "Manages a Typesense override/curation rule for a collection. In Typesense v29 and earlier, overrides are per-collection. In v30+, overrides are managed via curation sets at the system level (the collection name becomes the curation set name).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier (collection/name).",
+				Description: "Unique identifier (collection/name), using the collection name on the server, including any collection_name_prefix.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"collection": schema.StringAttribute{
-				Description: "The name of the collection this override belongs to. In v30+, this becomes the curation set name.",
+				Description: "The name of the collection this override belongs to. In v30+, this becomes the curation set name. The provider's collection_name_prefix is added when talking to the server.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -125,8 +128,11 @@ func (r *OverrideResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"filter_by": schema.StringAttribute{
-				Description: "Filter expression to apply.",
+				Description: "Filter expression to apply. Obvious syntax mistakes (unbalanced parentheses, clauses without a \":\", operators such as ==) produce a plan warning.",
 				Optional:    true,
+				Validators: []validator.String{
+					filterByValidator{},
+				},
 			},
 			"sort_by": schema.StringAttribute{
 				Description: "Sort expression to apply.",
@@ -162,10 +168,20 @@ func (r *OverrideResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"upsert_on_conflict": schema.BoolAttribute{
+				Description: "When true, an object that already exists when Terraform creates this one (a 409 Conflict) is adopted: the configuration is written over it and it is saved to state. " +
+					"When false, the create fails and names the ID to import the object with.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"includes": schema.ListNestedBlock{
-				Description: "Documents to include/pin in results.",
+				Description: "Documents to include/pin in results. Positions must be at least 1 and unique within the override.",
+				Validators: []validator.List{
+					overrideIncludesValidator{},
+				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
@@ -194,6 +210,59 @@ func (r *OverrideResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+// overrideIncludesValidator rejects include positions below 1 and positions
+// used by more than one include, which the server either rejects or resolves
+// in an unexpected order.
+type overrideIncludesValidator struct{}
+
+var _ validator.List = overrideIncludesValidator{}
+
+func (v overrideIncludesValidator) Description(ctx context.Context) string {
+	return "include positions must be at least 1 and unique"
+}
+
+func (v overrideIncludesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v overrideIncludesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := make(map[int64]int)
+	for i, elem := range req.ConfigValue.Elements() {
+		include, ok := elem.(types.Object)
+		if !ok || include.IsNull() || include.IsUnknown() {
+			continue
+		}
+		position, ok := include.Attributes()["position"].(types.Int64)
+		if !ok || position.IsNull() || position.IsUnknown() {
+			continue
+		}
+
+		positionPath := req.Path.AtListIndex(i).AtName("position")
+		value := position.ValueInt64()
+		if value < 1 {
+			resp.Diagnostics.AddAttributeError(
+				positionPath,
+				"Invalid Include Position",
+				fmt.Sprintf("Include positions start at 1, got %d.", value),
+			)
+			continue
+		}
+		if first, dup := seen[value]; dup {
+			resp.Diagnostics.AddAttributeError(
+				positionPath,
+				"Duplicate Include Position",
+				fmt.Sprintf("Position %d is already used by includes[%d]. Each pinned document needs its own position.", value, first),
+			)
+			continue
+		}
+		seen[value] = i
+	}
+}
+
 func (r *OverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -219,6 +288,7 @@ func (r *OverrideResource) Configure(ctx context.Context, req resource.Configure
 
 	r.client = providerData.ServerClient
 	r.featureChecker = providerData.FeatureChecker
+	r.prefix = collectionNamePrefix(providerData.CollectionNamePrefix)
 }
 
 func (r *OverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -236,31 +306,46 @@ func (r *OverrideResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	collection := data.Collection.ValueString()
+	collection := r.prefix.serverName(data.Collection.ValueString())
 
 	// Use version-appropriate API
 	if r.featureChecker.SupportsFeature(version.FeatureCurationSets) {
 		// v30+: Use curation sets API
-		err := r.createOverrideV30(ctx, collection, override)
+		create := func() error {
+			return r.createOverrideV30(ctx, collection, override)
+		}
+		exists := func() (bool, error) {
+			existing, err := r.getOverrideV30(ctx, collection, override.ID)
+			return existing != nil, err
+		}
+		err := createOrReportExisting(create, exists, upsertOnConflict(data.UpsertOnConflict, create), data.Collection.ValueString()+"/"+override.ID)
 		if err != nil {
 			serverVer := r.featureChecker.GetVersion()
 			detail := fmt.Sprintf("Unable to create override using v30+ curation sets API: %s", err)
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	} else if r.featureChecker.SupportsFeature(version.FeaturePerCollectionOverrides) || r.featureChecker.GetVersion() == nil {
 		// v29 and earlier (or unknown version): Use per-collection overrides API
-		_, err := r.client.CreateOverride(ctx, collection, override)
+		create := func() error {
+			_, err := r.client.CreateOverride(ctx, collection, override)
+			return err
+		}
+		exists := func() (bool, error) {
+			existing, err := r.client.GetOverride(ctx, collection, override.ID)
+			return existing != nil, err
+		}
+		err := createOrReportExisting(create, exists, upsertOnConflict(data.UpsertOnConflict, create), data.Collection.ValueString()+"/"+override.ID)
 		if err != nil {
 			serverVer := r.featureChecker.GetVersion()
 			detail := fmt.Sprintf("Unable to create override using per-collection overrides API: %s", err)
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s). Note: Per-collection overrides were removed in v30+. Use curation sets in v30+.", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	} else {
@@ -290,7 +375,7 @@ func (r *OverrideResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	collection := data.Collection.ValueString()
+	collection := r.prefix.serverName(data.Collection.ValueString())
 	name := data.Name.ValueString()
 
 	var override *client.Override
@@ -306,7 +391,7 @@ func (r *OverrideResource) Read(ctx context.Context, req resource.ReadRequest, r
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	} else {
@@ -318,7 +403,7 @@ func (r *OverrideResource) Read(ctx context.Context, req resource.ReadRequest, r
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	}
@@ -348,7 +433,7 @@ func (r *OverrideResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	collection := data.Collection.ValueString()
+	collection := r.prefix.serverName(data.Collection.ValueString())
 
 	// Use version-appropriate API
 	if r.featureChecker.SupportsFeature(version.FeatureCurationSets) {
@@ -360,7 +445,7 @@ func (r *OverrideResource) Update(ctx context.Context, req resource.UpdateReques
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	} else {
@@ -372,7 +457,7 @@ func (r *OverrideResource) Update(ctx context.Context, req resource.UpdateReques
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	}
@@ -389,7 +474,7 @@ func (r *OverrideResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	collection := data.Collection.ValueString()
+	collection := r.prefix.serverName(data.Collection.ValueString())
 	name := data.Name.ValueString()
 
 	// Use version-appropriate API
@@ -402,7 +487,7 @@ func (r *OverrideResource) Delete(ctx context.Context, req resource.DeleteReques
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	} else {
@@ -414,7 +499,7 @@ func (r *OverrideResource) Delete(ctx context.Context, req resource.DeleteReques
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	}
@@ -431,9 +516,24 @@ func (r *OverrideResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	// The import ID may name the collection with or without the provider's
+	// collection_name_prefix.
+	collection, name := r.prefix.serverName(r.prefix.configName(parts[0])), parts[1]
+
+	// Under v30 a missing item would make the follow-up Read silently drop
+	// the resource, so confirm the curation set and item exist up front.
+	if r.client != nil && r.featureChecker != nil && r.featureChecker.SupportsFeature(version.FeatureCurationSets) {
+		if err := r.verifyCurationItemExists(ctx, collection, name); err != nil {
+			resp.Diagnostics.AddError("Cannot Import Override", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), collection+"/"+name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), r.prefix.configName(collection))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	// upsert_on_conflict only lives in Terraform; import it at its default.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("upsert_on_conflict"), false)...)
 }
 
 func (r *OverrideResource) modelToOverride(ctx context.Context, data *OverrideResourceModel) (*client.Override, diag.Diagnostics) {
@@ -645,7 +745,29 @@ func (r *OverrideResource) getOverrideV30(ctx context.Context, collection, name
 		return nil, nil
 	}
 
-	return curationItemToOverride(item), nil
+	return client.CurationItemToOverride(item), nil
+}
+
+// verifyCurationItemExists returns an error naming whichever of the v30
+// curation set or item is missing.
+func (r *OverrideResource) verifyCurationItemExists(ctx context.Context, collection, name string) error {
+	set, err := r.client.GetCurationSet(ctx, collection)
+	if err != nil {
+		return fmt.Errorf("failed to read curation set %s: %w", collection, err)
+	}
+	if set == nil {
+		return fmt.Errorf("curation set %s does not exist", collection)
+	}
+
+	item, err := r.client.GetCurationSetItem(ctx, collection, name)
+	if err != nil {
+		return fmt.Errorf("failed to read item %s of curation set %s: %w", name, collection, err)
+	}
+	if item == nil {
+		return fmt.Errorf("curation set %s has no item %s", collection, name)
+	}
+
+	return nil
 }
 
 // deleteOverrideV30 removes an override from a v30 curation set.
@@ -680,28 +802,3 @@ func overrideToCurationItem(o *client.Override) client.CurationItem {
 	}
 	return ci
 }
-
-// curationItemToOverride converts a client.CurationItem to a client.Override.
-// A nil RemoveMatchedTokens pointer means the server stored no value; we
-// surface that as false so the model stays comparable with state read from
-// per-collection v29 endpoints.
-func curationItemToOverride(c *client.CurationItem) *client.Override {
-	rmt := false
-	if c.RemoveMatchedTokens != nil {
-		rmt = *c.RemoveMatchedTokens
-	}
-	return &client.Override{
-		ID:                  c.ID,
-		Rule:                c.Rule,
-		Includes:            c.Includes,
-		Excludes:            c.Excludes,
-		FilterBy:            c.FilterBy,
-		SortBy:              c.SortBy,
-		ReplaceQuery:        c.ReplaceQuery,
-		RemoveMatchedTokens: rmt,
-		FilterCuratedHits:   c.FilterCuratedHits,
-		EffectiveFromTs:     c.EffectiveFromTs,
-		EffectiveToTs:       c.EffectiveToTs,
-		StopProcessing:      c.StopProcessing,
-	}
-}
@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var overrideIncludeAttrTypes = map[string]attr.Type{
+	"id":       types.StringType,
+	"position": types.Int64Type,
+}
+
+func includeList(positions ...attr.Value) types.List {
+	includes := make([]attr.Value, len(positions))
+	for i, position := range positions {
+		includes[i] = types.ObjectValueMust(overrideIncludeAttrTypes, map[string]attr.Value{
+			"id":       types.StringValue("doc"),
+			"position": position,
+		})
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: overrideIncludeAttrTypes}, includes)
+}
+
+func TestOverrideIncludesValidator(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      types.List
+		wantErrors int
+	}{
+		{name: "distinct positions", value: includeList(types.Int64Value(1), types.Int64Value(2)), wantErrors: 0},
+		{name: "zero position", value: includeList(types.Int64Value(0)), wantErrors: 1},
+		{name: "negative position", value: includeList(types.Int64Value(-3)), wantErrors: 1},
+		{name: "duplicate position", value: includeList(types.Int64Value(1), types.Int64Value(2), types.Int64Value(1)), wantErrors: 1},
+		{name: "unknown position", value: includeList(types.Int64Value(1), types.Int64Unknown()), wantErrors: 0},
+		{name: "null list", value: types.ListNull(types.ObjectType{AttrTypes: overrideIncludeAttrTypes}), wantErrors: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ListRequest{Path: path.Root("includes"), ConfigValue: tt.value}
+			var resp validator.ListResponse
+
+			overrideIncludesValidator{}.ValidateList(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Fatalf("got %d errors, want %d: %v", got, tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestOverrideIncludesValidatorNamesDuplicatePosition(t *testing.T) {
+	req := validator.ListRequest{Path: path.Root("includes"), ConfigValue: includeList(types.Int64Value(2), types.Int64Value(2))}
+	var resp validator.ListResponse
+
+	overrideIncludesValidator{}.ValidateList(context.Background(), req, &resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want 1: %v", len(errs), errs)
+	}
+	if !strings.Contains(errs[0].Detail(), "Position 2") || !strings.Contains(errs[0].Detail(), "includes[0]") {
+		t.Errorf("error detail = %q, want the duplicated position and the include that uses it first", errs[0].Detail())
+	}
+	withPath, ok := errs[0].(diag.DiagnosticWithPath)
+	if want := path.Root("includes").AtListIndex(1).AtName("position"); !ok || !withPath.Path().Equal(want) {
+		t.Errorf("error = %v, want it attached to %v", errs[0], want)
+	}
+}
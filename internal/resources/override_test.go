@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/alanm/terraform-provider-typesense/internal/provider"
@@ -132,6 +133,39 @@ func TestAccOverrideResource_replaceQuery(t *testing.T) {
 	})
 }
 
+// TestAccOverrideResource_importCurationItem imports an override by
+// collection/name and then tries an item that does not exist. On v30 servers
+// the import is checked against the curation set; on older servers Terraform
+// itself rejects the empty read.
+func TestAccOverrideResource_importCurationItem(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-collection")
+	overrideName := acctest.RandomWithPrefix("test-override")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOverrideResourceConfig_includes(rName, overrideName),
+			},
+			{
+				ResourceName:      "typesense_override.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/%s", rName, overrideName),
+			},
+			{
+				ResourceName:  "typesense_override.test",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s/does-not-exist", rName),
+				ExpectError: regexp.MustCompile(
+					fmt.Sprintf(`curation set %s has no item does-not-exist|Cannot import non-existent remote object`, regexp.QuoteMeta(rName)),
+				),
+			},
+		},
+	})
+}
+
 func testAccOverrideResourceConfig_includes(collectionName, overrideName string) string {
 	return fmt.Sprintf(`
 resource "typesense_collection" "test" {
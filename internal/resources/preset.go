@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/alanm/terraform-provider-typesense/internal/client"
 	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
@@ -12,8 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,9 +36,10 @@ type PresetResource struct {
 
 // PresetResourceModel describes the resource data model.
 type PresetResourceModel struct {
-	ID    types.String `tfsdk:"id"`
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Value            types.String `tfsdk:"value"`
+	UpsertOnConflict types.Bool   `tfsdk:"upsert_on_conflict"`
 }
 
 func (r *PresetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,8 +65,20 @@ func (r *PresetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"value": schema.StringAttribute{
-				Description: "JSON-encoded search parameters for this preset. Can include any valid search parameters like q, query_by, filter_by, sort_by, facet_by, per_page, etc.",
-				Required:    true,
+				Description: "JSON-encoded search parameters for this preset. Can include any valid search parameters like q, query_by, filter_by, sort_by, facet_by, per_page, etc. " +
+					"Obvious syntax mistakes in filter_by, and a query_by_weights list whose length differs from query_by, produce a plan warning.",
+				Required: true,
+				Validators: []validator.String{
+					presetFilterByValidator{},
+					presetQueryByWeightsValidator{},
+				},
+			},
+			"upsert_on_conflict": schema.BoolAttribute{
+				Description: "When true, an object that already exists when Terraform creates this one (a 409 Conflict) is adopted: the configuration is written over it and it is saved to state. " +
+					"When false, the create fails and names the ID to import the object with.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -110,7 +126,7 @@ func (r *PresetResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Parse the JSON value
-	var value map[string]any
+	var value client.JSONObject
 	if err := json.Unmarshal([]byte(data.Value.ValueString()), &value); err != nil {
 		resp.Diagnostics.AddError("Invalid JSON", fmt.Sprintf("The value field must be valid JSON: %s", err))
 		return
@@ -121,13 +137,20 @@ func (r *PresetResource) Create(ctx context.Context, req resource.CreateRequest,
 		Value: value,
 	}
 
-	created, err := r.client.UpsertPreset(ctx, preset)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create preset: %s", err))
+	create := func() error {
+		_, err := r.client.UpsertPreset(ctx, preset)
+		return err
+	}
+	exists := func() (bool, error) {
+		existing, err := r.client.GetPreset(ctx, preset.Name)
+		return existing != nil, err
+	}
+	if err := createOrReportExisting(create, exists, upsertOnConflict(data.UpsertOnConflict, create), preset.Name); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create preset: %s", err))
 		return
 	}
 
-	data.ID = types.StringValue(created.Name)
+	data.ID = types.StringValue(preset.Name)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -152,13 +175,17 @@ func (r *PresetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Convert value back to JSON string
-	valueBytes, err := json.Marshal(preset.Value)
-	if err != nil {
-		resp.Diagnostics.AddError("Serialization Error", fmt.Sprintf("Unable to serialize preset value: %s", err))
-		return
+	// Keep the configured formatting while the server holds the same value;
+	// only a real change is written back as the server's JSON.
+	var current client.JSONObject
+	if err := json.Unmarshal([]byte(data.Value.ValueString()), &current); err != nil || !jsonObjectsEqual(current, preset.Value) {
+		valueBytes, err := json.Marshal(preset.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Serialization Error", fmt.Sprintf("Unable to serialize preset value: %s", err))
+			return
+		}
+		data.Value = types.StringValue(string(valueBytes))
 	}
-	data.Value = types.StringValue(string(valueBytes))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -173,7 +200,7 @@ func (r *PresetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Parse the JSON value
-	var value map[string]any
+	var value client.JSONObject
 	if err := json.Unmarshal([]byte(data.Value.ValueString()), &value); err != nil {
 		resp.Diagnostics.AddError("Invalid JSON", fmt.Sprintf("The value field must be valid JSON: %s", err))
 		return
@@ -212,4 +239,65 @@ func (r *PresetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *PresetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	// upsert_on_conflict only lives in Terraform; import it at its default.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("upsert_on_conflict"), false)...)
+}
+
+// presetQueryByWeightsValidator warns when a preset gives query_by_weights a
+// different number of entries than query_by, in the top-level parameters or
+// in an entry of a multi-search "searches" list. Typesense rejects such
+// searches, but only when the preset is used. Other keys are not checked.
+type presetQueryByWeightsValidator struct{}
+
+var _ validator.String = presetQueryByWeightsValidator{}
+
+func (v presetQueryByWeightsValidator) Description(ctx context.Context) string {
+	return "query_by_weights should have one weight per query_by field"
+}
+
+func (v presetQueryByWeightsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v presetQueryByWeightsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	type searchParams struct {
+		QueryBy        any `json:"query_by"`
+		QueryByWeights any `json:"query_by_weights"`
+	}
+	var value struct {
+		searchParams
+		Searches []searchParams `json:"searches"`
+	}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &value); err != nil {
+		return
+	}
+
+	for _, params := range append([]searchParams{value.searchParams}, value.Searches...) {
+		queryBy, ok := params.QueryBy.(string)
+		if !ok {
+			continue
+		}
+		var weights int
+		switch w := params.QueryByWeights.(type) {
+		case string:
+			weights = len(strings.Split(w, ","))
+		case float64:
+			// A single weight may be written as a number.
+			weights = 1
+		default:
+			continue
+		}
+		if fields := len(strings.Split(queryBy, ",")); fields != weights {
+			resp.Diagnostics.AddAttributeWarning(
+				req.Path,
+				"Mismatched query_by_weights",
+				fmt.Sprintf("The preset's query_by %q names %d field(s), but query_by_weights %v has %d weight(s). Typesense rejects searches using the preset unless there is one weight per field.",
+					queryBy, fields, params.QueryByWeights, weights),
+			)
+		}
+	}
 }
@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPresetQueryByWeightsValidator(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		value    string
+		warnings int
+	}{
+		{name: "matching lengths", value: `{"query_by": "title,description", "query_by_weights": "4,1"}`},
+		{name: "too few weights", value: `{"query_by": "title,description,brand", "query_by_weights": "4,1"}`, warnings: 1},
+		{name: "numeric weight for one field", value: `{"query_by": "title", "query_by_weights": 2}`},
+		{name: "numeric weight for two fields", value: `{"query_by": "title,brand", "query_by_weights": 2}`, warnings: 1},
+		{name: "weights without query_by", value: `{"query_by_weights": "4,1"}`},
+		{name: "query_by without weights", value: `{"query_by": "title,brand"}`},
+		{name: "searches", value: `{"searches": [{"query_by": "a,b", "query_by_weights": "1,1"}, {"query_by": "a", "query_by_weights": "1,2"}]}`, warnings: 1},
+		{name: "invalid json", value: `{"query_by": `},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("value"), ConfigValue: types.StringValue(tt.value)}
+			resp := &validator.StringResponse{}
+			presetQueryByWeightsValidator{}.ValidateString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tt.warnings {
+				t.Errorf("warnings = %d (%v), want %d", got, resp.Diagnostics, tt.warnings)
+			}
+		})
+	}
+}
+
+func TestPresetQueryByWeightsValidatorNamesCounts(t *testing.T) {
+	req := validator.StringRequest{
+		Path:        path.Root("value"),
+		ConfigValue: types.StringValue(`{"query_by": "title,description,brand", "query_by_weights": "4,1"}`),
+	}
+	resp := &validator.StringResponse{}
+	presetQueryByWeightsValidator{}.ValidateString(context.Background(), req, resp)
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %v", len(warnings), warnings)
+	}
+	if detail := warnings[0].Detail(); !strings.Contains(detail, "3 field(s)") || !strings.Contains(detail, "2 weight(s)") {
+		t.Errorf("warning detail = %q, want both counts", detail)
+	}
+}
+
+func TestPresetReadKeepsNumberForms(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  string
+		server string
+		want   string
+	}{
+		{name: "unchanged", state: `{"per_page": 10, "drop_tokens_threshold": 1.0}`, server: `{"per_page": 10, "drop_tokens_threshold": 1}`, want: `{"per_page": 10, "drop_tokens_threshold": 1.0}`},
+		{name: "changed outside Terraform", state: `{"per_page": 10}`, server: `{"per_page": 20, "typo_tokens_threshold": 0.5}`, want: `{"per_page":20,"typo_tokens_threshold":0.5}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"name": "listing", "value": ` + tt.server + `}`))
+			})
+
+			ctx := context.Background()
+			r := &PresetResource{client: newTestServerClient(t, handler)}
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			data := PresetResourceModel{ID: types.StringValue("listing"), Name: types.StringValue("listing"), Value: types.StringValue(tt.state)}
+			if diags := state.Set(ctx, &data); diags.HasError() {
+				t.Fatalf("failed to build state: %v", diags)
+			}
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", resp.Diagnostics)
+			}
+
+			var value types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("value"), &value)...)
+			if value.ValueString() != tt.want {
+				t.Errorf("value = %s, want %s", value, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
+	"unicode"
 
 	"github.com/alanm/terraform-provider-typesense/internal/client"
 	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +21,7 @@ import (
 
 var _ resource.Resource = &StopwordsSetResource{}
 var _ resource.ResourceWithImportState = &StopwordsSetResource{}
+var _ resource.ResourceWithModifyPlan = &StopwordsSetResource{}
 
 // NewStopwordsSetResource creates a new stopwords set resource
 func NewStopwordsSetResource() resource.Resource {
@@ -32,10 +36,11 @@ type StopwordsSetResource struct {
 
 // StopwordsSetResourceModel describes the resource data model.
 type StopwordsSetResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Stopwords types.Set    `tfsdk:"stopwords"`
-	Locale    types.String `tfsdk:"locale"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Stopwords        types.Set    `tfsdk:"stopwords"`
+	Locale           types.String `tfsdk:"locale"`
+	UpsertOnConflict types.Bool   `tfsdk:"upsert_on_conflict"`
 }
 
 func (r *StopwordsSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +74,13 @@ func (r *StopwordsSetResource) Schema(ctx context.Context, req resource.SchemaRe
 				Description: "Locale for the stopwords (e.g., 'en', 'de').",
 				Optional:    true,
 			},
+			"upsert_on_conflict": schema.BoolAttribute{
+				Description: "When true, an object that already exists when Terraform creates this one (a 409 Conflict) is adopted: the configuration is written over it and it is saved to state. " +
+					"When false, the create fails and names the ID to import the object with.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -100,6 +112,35 @@ func (r *StopwordsSetResource) Configure(ctx context.Context, req resource.Confi
 	r.featureChecker = providerData.FeatureChecker
 }
 
+// ModifyPlan warns when the declared locale's writing system does not match
+// the dominant script of the stopwords, e.g. locale "ja" with English words.
+// This is only a nudge; the plan is never rejected.
+func (r *StopwordsSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan StopwordsSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Locale.IsNull() || plan.Locale.IsUnknown() || plan.Stopwords.IsNull() || plan.Stopwords.IsUnknown() {
+		return
+	}
+
+	var stopwords []string
+	resp.Diagnostics.Append(plan.Stopwords.ElementsAs(ctx, &stopwords, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if detail, mismatch := stopwordsLocaleMismatch(plan.Locale.ValueString(), stopwords); mismatch {
+		resp.Diagnostics.AddAttributeWarning(path.Root("locale"), "Stopwords May Not Match Locale", detail)
+	}
+}
+
 func (r *StopwordsSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if diags := version.CheckVersionRequirement(r.featureChecker, version.FeatureStopwords, tfnames.FullTypeName(tfnames.ResourceStopwordsSet)); diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -129,13 +170,20 @@ func (r *StopwordsSetResource) Create(ctx context.Context, req resource.CreateRe
 		stopwordsSet.Locale = data.Locale.ValueString()
 	}
 
-	created, err := r.client.CreateStopwordsSet(ctx, stopwordsSet)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create stopwords set: %s", err))
+	create := func() error {
+		_, err := r.client.CreateStopwordsSet(ctx, stopwordsSet)
+		return err
+	}
+	exists := func() (bool, error) {
+		existing, err := r.client.GetStopwordsSet(ctx, stopwordsSet.ID)
+		return existing != nil, err
+	}
+	if err := createOrReportExisting(create, exists, upsertOnConflict(data.UpsertOnConflict, create), stopwordsSet.ID); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create stopwords set: %s", err))
 		return
 	}
 
-	data.ID = types.StringValue(created.ID)
+	data.ID = types.StringValue(stopwordsSet.ID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -226,4 +274,107 @@ func (r *StopwordsSetResource) Delete(ctx context.Context, req resource.DeleteRe
 func (r *StopwordsSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	// upsert_on_conflict only lives in Terraform; import it at its default.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("upsert_on_conflict"), false)...)
+}
+
+// localeScripts maps a locale's base language to the Unicode scripts its
+// words are normally written in. Locales not listed here are not checked.
+var localeScripts = map[string][]*unicode.RangeTable{
+	"ja": {unicode.Han, unicode.Hiragana, unicode.Katakana},
+	"zh": {unicode.Han},
+	"ko": {unicode.Hangul, unicode.Han},
+	"ru": {unicode.Cyrillic},
+	"uk": {unicode.Cyrillic},
+	"bg": {unicode.Cyrillic},
+	"sr": {unicode.Cyrillic, unicode.Latin},
+	"el": {unicode.Greek},
+	"ar": {unicode.Arabic},
+	"fa": {unicode.Arabic},
+	"ur": {unicode.Arabic},
+	"he": {unicode.Hebrew},
+	"th": {unicode.Thai},
+	"hi": {unicode.Devanagari},
+	"mr": {unicode.Devanagari},
+	"ne": {unicode.Devanagari},
+	"en": {unicode.Latin},
+	"de": {unicode.Latin},
+	"fr": {unicode.Latin},
+	"es": {unicode.Latin},
+	"it": {unicode.Latin},
+	"pt": {unicode.Latin},
+	"nl": {unicode.Latin},
+	"sv": {unicode.Latin},
+	"da": {unicode.Latin},
+	"no": {unicode.Latin},
+	"fi": {unicode.Latin},
+	"pl": {unicode.Latin},
+	"cs": {unicode.Latin},
+	"tr": {unicode.Latin},
+	"vi": {unicode.Latin},
+	"id": {unicode.Latin},
+}
+
+// scriptNames gives human-readable names for the scripts used in localeScripts.
+var scriptNames = map[*unicode.RangeTable]string{
+	unicode.Han:        "Han",
+	unicode.Hiragana:   "Hiragana",
+	unicode.Katakana:   "Katakana",
+	unicode.Hangul:     "Hangul",
+	unicode.Cyrillic:   "Cyrillic",
+	unicode.Latin:      "Latin",
+	unicode.Greek:      "Greek",
+	unicode.Arabic:     "Arabic",
+	unicode.Hebrew:     "Hebrew",
+	unicode.Thai:       "Thai",
+	unicode.Devanagari: "Devanagari",
+}
+
+// stopwordsLocaleMismatch reports whether the dominant script of the
+// stopwords differs from the scripts expected for the locale, returning a
+// warning detail when it does.
+func stopwordsLocaleMismatch(locale string, stopwords []string) (string, bool) {
+	base := strings.ToLower(locale)
+	if i := strings.IndexAny(base, "-_"); i >= 0 {
+		base = base[:i]
+	}
+
+	expected, ok := localeScripts[base]
+	if !ok {
+		return "", false
+	}
+
+	counts := make(map[*unicode.RangeTable]int)
+	for _, word := range stopwords {
+		for _, ch := range word {
+			for script := range scriptNames {
+				if unicode.Is(script, ch) {
+					counts[script]++
+					break
+				}
+			}
+		}
+	}
+
+	var dominant *unicode.RangeTable
+	for script, n := range counts {
+		if dominant == nil || n > counts[dominant] || (n == counts[dominant] && scriptNames[script] < scriptNames[dominant]) {
+			dominant = script
+		}
+	}
+	if dominant == nil {
+		return "", false
+	}
+
+	for _, script := range expected {
+		if script == dominant {
+			return "", false
+		}
+	}
+
+	return fmt.Sprintf(
+		"The locale %q is usually written in %s script, but most of the stopwords are written in %s script. "+
+			"Check that the locale matches the language of the stopwords.",
+		locale, scriptNames[expected[0]], scriptNames[dominant],
+	), true
 }
@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStopwordsSetModifyPlanWarnsOnLocaleScriptMismatch(t *testing.T) {
+	ctx := context.Background()
+	r := &StopwordsSetResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	stopwords := []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "the"),
+		tftypes.NewValue(tftypes.String, "and"),
+		tftypes.NewValue(tftypes.String, "of"),
+	}
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":               tftypes.NewValue(tftypes.String, "japanese"),
+			"stopwords":          tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, stopwords),
+			"locale":             tftypes.NewValue(tftypes.String, "ja"),
+			"upsert_on_conflict": tftypes.NewValue(tftypes.Bool, false),
+		}),
+	}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan returned errors: %v", resp.Diagnostics)
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %v", len(warnings), warnings)
+	}
+	if !strings.Contains(warnings[0].Detail(), "Latin") {
+		t.Errorf("warning detail = %q, want it to name the Latin script", warnings[0].Detail())
+	}
+}
+
+func TestStopwordsLocaleMismatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		locale    string
+		stopwords []string
+		want      bool
+	}{
+		{name: "english words with ja locale", locale: "ja", stopwords: []string{"the", "a", "an"}, want: true},
+		{name: "japanese words with ja locale", locale: "ja", stopwords: []string{"の", "に", "は", "です"}, want: false},
+		{name: "english words with en locale", locale: "en", stopwords: []string{"the", "a", "an"}, want: false},
+		{name: "cyrillic words with en locale", locale: "en-US", stopwords: []string{"и", "в", "не"}, want: true},
+		{name: "unknown locale is not checked", locale: "xx", stopwords: []string{"the"}, want: false},
+		{name: "no letters is not checked", locale: "ja", stopwords: []string{"123"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := stopwordsLocaleMismatch(tt.locale, tt.stopwords); got != tt.want {
+				t.Errorf("stopwordsLocaleMismatch(%q, %v) = %v, want %v", tt.locale, tt.stopwords, got, tt.want)
+			}
+		})
+	}
+}
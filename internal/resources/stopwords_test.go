@@ -94,6 +94,36 @@ func TestAccStopwordsSetResource_update(t *testing.T) {
 	})
 }
 
+// TestAccStopwordsSetResource_multipleLocales creates one set per locale;
+// each set carries a single locale, so per-locale setups use separate IDs.
+func TestAccStopwordsSetResource_multipleLocales(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-stopwords")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStopwordsSetResourceConfig_multipleLocales(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_stopwords_set.en", "name", rName+"-en"),
+					resource.TestCheckResourceAttr("typesense_stopwords_set.en", "locale", "en"),
+					resource.TestCheckResourceAttr("typesense_stopwords_set.en", "stopwords.#", "3"),
+					resource.TestCheckResourceAttr("typesense_stopwords_set.fr", "name", rName+"-fr"),
+					resource.TestCheckResourceAttr("typesense_stopwords_set.fr", "locale", "fr"),
+					resource.TestCheckResourceAttr("typesense_stopwords_set.fr", "stopwords.#", "3"),
+					resource.TestCheckTypeSetElemAttr("typesense_stopwords_set.fr", "stopwords.*", "le"),
+				),
+			},
+			{
+				ResourceName:      "typesense_stopwords_set.fr",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccStopwordsSetResourceConfig_basic(name string) string {
 	return fmt.Sprintf(`
 resource "typesense_stopwords_set" "test" {
@@ -121,3 +151,19 @@ resource "typesense_stopwords_set" "test" {
 }
 `, name)
 }
+
+func testAccStopwordsSetResourceConfig_multipleLocales(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_stopwords_set" "en" {
+  name      = "%[1]s-en"
+  locale    = "en"
+  stopwords = ["the", "a", "an"]
+}
+
+resource "typesense_stopwords_set" "fr" {
+  name      = "%[1]s-fr"
+  locale    = "fr"
+  stopwords = ["le", "la", "les"]
+}
+`, name)
+}
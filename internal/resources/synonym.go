@@ -13,17 +13,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// upgradedSynonymSetSuffix names the synonym set the v30 upgrade creates for
+// each collection's existing synonyms.
+const upgradedSynonymSetSuffix = "_synonyms_index"
+
 // synonymSetMu serializes v30 set ensure + item upsert sequences to prevent
 // empty-set creates from overwriting items added by other Terraform resources.
 var synonymSetMu sync.Map // map[string]*sync.Mutex
 
 var _ resource.Resource = &SynonymResource{}
 var _ resource.ResourceWithImportState = &SynonymResource{}
+var _ resource.ResourceWithModifyPlan = &SynonymResource{}
 
 // NewSynonymResource creates a new synonym resource
 func NewSynonymResource() resource.Resource {
@@ -34,15 +40,17 @@ func NewSynonymResource() resource.Resource {
 type SynonymResource struct {
 	client         *client.ServerClient
 	featureChecker version.FeatureChecker
+	prefix         collectionNamePrefix
 }
 
 // SynonymResourceModel describes the resource data model.
 type SynonymResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Collection types.String `tfsdk:"collection"`
-	Name       types.String `tfsdk:"name"`
-	Root       types.String `tfsdk:"root"`
-	Synonyms   types.List   `tfsdk:"synonyms"`
+	ID               types.String `tfsdk:"id"`
+	Collection       types.String `tfsdk:"collection"`
+	Name             types.String `tfsdk:"name"`
+	Root             types.String `tfsdk:"root"`
+	Synonyms         types.List   `tfsdk:"synonyms"`
+	UpsertOnConflict types.Bool   `tfsdk:"upsert_on_conflict"`
 }
 
 func (r *SynonymResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -54,14 +62,14 @@ func (r *SynonymResource) Schema(ctx context.Context, req resource.SchemaRequest
 		Description: "Manages a Typesense synonym configuration for a collection. In Typesense v29 and earlier, synonyms are per-collection. In v30+, synonyms are managed via synonym sets at the system level (the collection name becomes the synonym set name).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Unique identifier (collection/name).",
+				Description: "Unique identifier (collection/name), using the collection name on the server, including any collection_name_prefix.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"collection": schema.StringAttribute{
-				Description: "The name of the collection this synonym belongs to. In v30+, this becomes the synonym set name.",
+				Description: "The name of the collection this synonym belongs to. In v30+, this becomes the synonym set name. The provider's collection_name_prefix is added when talking to the server.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -83,6 +91,13 @@ func (r *SynonymResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:    true,
 				ElementType: types.StringType,
 			},
+			"upsert_on_conflict": schema.BoolAttribute{
+				Description: "When true, an object that already exists when Terraform creates this one (a 409 Conflict) is adopted: the configuration is written over it and it is saved to state. " +
+					"When false, the create fails and names the ID to import the object with.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -112,6 +127,7 @@ func (r *SynonymResource) Configure(ctx context.Context, req resource.ConfigureR
 
 	r.client = providerData.ServerClient
 	r.featureChecker = providerData.FeatureChecker
+	r.prefix = collectionNamePrefix(providerData.CollectionNamePrefix)
 }
 
 func (r *SynonymResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -129,7 +145,7 @@ func (r *SynonymResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	collection := data.Collection.ValueString()
+	collection := r.prefix.serverName(data.Collection.ValueString())
 	name := data.Name.ValueString()
 	root := ""
 	if !data.Root.IsNull() {
@@ -139,14 +155,21 @@ func (r *SynonymResource) Create(ctx context.Context, req resource.CreateRequest
 	// Use version-appropriate API
 	if r.featureChecker.SupportsFeature(version.FeatureSynonymSets) {
 		// v30+: Use synonym sets API
-		err := r.createSynonymV30(ctx, collection, name, root, synonyms)
+		create := func() error {
+			return r.createSynonymV30(ctx, collection, name, root, synonyms)
+		}
+		exists := func() (bool, error) {
+			existing, err := r.getSynonymV30(ctx, collection, name)
+			return existing != nil, err
+		}
+		err := createOrReportExisting(create, exists, upsertOnConflict(data.UpsertOnConflict, create), data.Collection.ValueString()+"/"+name)
 		if err != nil {
 			serverVer := r.featureChecker.GetVersion()
 			detail := fmt.Sprintf("Unable to create synonym using v30+ synonym sets API: %s", err)
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	} else if r.featureChecker.SupportsFeature(version.FeaturePerCollectionSynonyms) || r.featureChecker.GetVersion() == nil {
@@ -157,14 +180,22 @@ func (r *SynonymResource) Create(ctx context.Context, req resource.CreateRequest
 			Root:     root,
 		}
 
-		_, err := r.client.CreateSynonym(ctx, collection, synonym)
+		create := func() error {
+			_, err := r.client.CreateSynonym(ctx, collection, synonym)
+			return err
+		}
+		exists := func() (bool, error) {
+			existing, err := r.client.GetSynonym(ctx, collection, name)
+			return existing != nil, err
+		}
+		err := createOrReportExisting(create, exists, upsertOnConflict(data.UpsertOnConflict, create), data.Collection.ValueString()+"/"+name)
 		if err != nil {
 			serverVer := r.featureChecker.GetVersion()
 			detail := fmt.Sprintf("Unable to create synonym using per-collection synonyms API: %s", err)
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s). Note: Per-collection synonyms were removed in v30+. Use synonym sets in v30+.", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	} else {
@@ -194,7 +225,7 @@ func (r *SynonymResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	collection := data.Collection.ValueString()
+	collection := r.prefix.serverName(data.Collection.ValueString())
 	name := data.Name.ValueString()
 
 	var synonyms []string
@@ -211,9 +242,21 @@ func (r *SynonymResource) Read(ctx context.Context, req resource.ReadRequest, re
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
+		if synItem == nil {
+			synItem, err = r.client.GetSynonymSetItem(ctx, collection+upgradedSynonymSetSuffix, name)
+			if err != nil {
+				resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read synonym created before the v30 upgrade from synonym set %s: %s", collection+upgradedSynonymSetSuffix, err))
+				return
+			}
+			if synItem != nil {
+				// Reported where it lives; ModifyPlan plans it back to the
+				// collection's set and Update moves it there.
+				data.ID = types.StringValue(upgradedSynonymID(collection, name))
+			}
+		}
 		if synItem != nil {
 			found = true
 			synonyms = synItem.Synonyms
@@ -228,7 +271,7 @@ func (r *SynonymResource) Read(ctx context.Context, req resource.ReadRequest, re
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 		if synonym != nil {
@@ -274,7 +317,7 @@ func (r *SynonymResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	collection := data.Collection.ValueString()
+	collection := r.prefix.serverName(data.Collection.ValueString())
 	name := data.Name.ValueString()
 	root := ""
 	if !data.Root.IsNull() {
@@ -291,9 +334,21 @@ func (r *SynonymResource) Update(ctx context.Context, req resource.UpdateRequest
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
+
+		var state SynonymResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.ID.ValueString() == upgradedSynonymID(collection, name) {
+			if err := r.deleteSynonymV30(ctx, collection+upgradedSynonymSetSuffix, name); err != nil {
+				resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Synonym was copied into synonym set %s but could not be removed from %s: %s", collection, collection+upgradedSynonymSetSuffix, err))
+				return
+			}
+		}
 	} else {
 		// v29 and earlier (or unknown version): Use per-collection synonyms API
 		synonym := &client.Synonym{
@@ -309,11 +364,13 @@ func (r *SynonymResource) Update(ctx context.Context, req resource.UpdateRequest
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	}
 
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", collection, name))
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -326,20 +383,25 @@ func (r *SynonymResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	collection := data.Collection.ValueString()
+	collection := r.prefix.serverName(data.Collection.ValueString())
 	name := data.Name.ValueString()
 
 	// Use version-appropriate API
 	if r.featureChecker.SupportsFeature(version.FeatureSynonymSets) {
-		// v30+: Use synonym sets API
-		err := r.deleteSynonymV30(ctx, collection, name)
+		// v30+: Use synonym sets API. A synonym not yet moved out of the set
+		// the v30 upgrade created is deleted there.
+		set := collection
+		if data.ID.ValueString() == upgradedSynonymID(collection, name) {
+			set = collection + upgradedSynonymSetSuffix
+		}
+		err := r.deleteSynonymV30(ctx, set, name)
 		if err != nil {
 			serverVer := r.featureChecker.GetVersion()
 			detail := fmt.Sprintf("Unable to delete synonym using v30+ synonym sets API: %s", err)
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	} else {
@@ -351,7 +413,7 @@ func (r *SynonymResource) Delete(ctx context.Context, req resource.DeleteRequest
 			if serverVer != nil {
 				detail += fmt.Sprintf(" (server version: v%s)", serverVer.String())
 			}
-			resp.Diagnostics.AddError("Client Error", detail)
+			resp.Diagnostics.AddError(clientErrorSummary(err), detail)
 			return
 		}
 	}
@@ -368,9 +430,15 @@ func (r *SynonymResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), parts[0])...)
+	// The import ID may name the collection with or without the provider's
+	// collection_name_prefix.
+	collection := r.prefix.configName(parts[0])
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.prefix.serverName(collection)+"/"+parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection"), collection)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	// upsert_on_conflict only lives in Terraform; import it at its default.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("upsert_on_conflict"), false)...)
 }
 
 // v30+ helper methods for synonym sets
@@ -388,28 +456,81 @@ func (r *SynonymResource) ensureSynonymSetExists(ctx context.Context, collection
 
 // createSynonymV30 creates or updates a synonym using the v30 synonym sets item-level API.
 // The collection name is used as the synonym set name.
+//
+// synonymSetMu only serializes writers within this process. Another Terraform
+// run creating the same set can still replace it with an empty one between our
+// set check and item write, so the item is read back and rewritten if it went
+// missing, up to the provider's synonym_set_write_retries times.
 func (r *SynonymResource) createSynonymV30(ctx context.Context, collection, name, root string, synonyms []string) error {
 	mu := getSetMutex(collection)
 	mu.Lock()
 	defer mu.Unlock()
 
-	// Ensure the synonym set exists before using the item-level API.
-	if err := r.ensureSynonymSetExists(ctx, collection); err != nil {
-		return fmt.Errorf("failed to ensure synonym set: %w", err)
-	}
-
-	// Use item-level API (safe for concurrent access)
 	item := &client.SynonymItem{
 		ID:       name,
 		Root:     root,
 		Synonyms: synonyms,
 	}
-	_, err := r.client.UpsertSynonymSetItem(ctx, collection, item)
-	if err != nil {
-		return fmt.Errorf("failed to upsert synonym item: %w", err)
+
+	attempts := r.client.SynonymSetWriteAttempts()
+	for attempt := 1; attempt <= attempts; attempt++ {
+		// Ensure the synonym set exists before using the item-level API.
+		if err := r.ensureSynonymSetExists(ctx, collection); err != nil {
+			return fmt.Errorf("failed to ensure synonym set: %w", err)
+		}
+
+		// Use item-level API (safe for concurrent access)
+		if _, err := r.client.UpsertSynonymSetItem(ctx, collection, item); err != nil {
+			return fmt.Errorf("failed to upsert synonym item: %w", err)
+		}
+
+		written, err := r.client.GetSynonymSetItem(ctx, collection, name)
+		if err != nil {
+			return fmt.Errorf("failed to confirm synonym item: %w", err)
+		}
+		if written != nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("synonym item %s kept disappearing from synonym set %s after %d attempts; another process is rewriting the set", name, collection, attempts)
+}
+
+// upgradedSynonymID is the id Read reports for a synonym created before the
+// server was upgraded from v29. The v30 upgrade moves per-collection synonyms
+// into a set named "<collection>_synonyms_index", while this resource manages
+// them in the set named after the collection.
+func upgradedSynonymID(collection, name string) string {
+	return collection + upgradedSynonymSetSuffix + "/" + name
+}
+
+// ModifyPlan plans a synonym that Read found in the set the v30 upgrade
+// created back under its collection's set, so the next apply moves it there
+// through Update. Read itself never writes to the server.
+func (r *SynonymResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan SynonymResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Collection.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	collection := r.prefix.serverName(plan.Collection.ValueString())
+	name := plan.Name.ValueString()
+	if state.ID.ValueString() != upgradedSynonymID(collection, name) {
+		return
 	}
 
-	return nil
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", collection, name))...)
+	resp.Diagnostics.AddWarning(
+		"Synonym Moves to Its Collection's Synonym Set",
+		fmt.Sprintf("Synonym %q was found in synonym set %s, where the Typesense v30 upgrade moved it. Applying copies it into synonym set %s and removes it from %s.",
+			name, collection+upgradedSynonymSetSuffix, collection, collection+upgradedSynonymSetSuffix),
+	)
 }
 
 // getSynonymV30 retrieves a specific synonym from a v30 synonym set.
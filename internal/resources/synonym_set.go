@@ -0,0 +1,362 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/tfnames"
+	providertypes "github.com/alanm/terraform-provider-typesense/internal/types"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &SynonymSetResource{}
+var _ resource.ResourceWithImportState = &SynonymSetResource{}
+var _ resource.ResourceWithValidateConfig = &SynonymSetResource{}
+
+// NewSynonymSetResource creates a new synonym set resource
+func NewSynonymSetResource() resource.Resource {
+	return &SynonymSetResource{}
+}
+
+// SynonymSetResource manages a whole v30 synonym set, items included, with
+// one upsert of the set. Unlike typesense_synonym, which adds single items to
+// a set that other resources may share, it owns every item in the set.
+type SynonymSetResource struct {
+	client         *client.ServerClient
+	featureChecker version.FeatureChecker
+}
+
+// SynonymSetResourceModel describes the resource data model.
+type SynonymSetResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Items types.List   `tfsdk:"item"`
+}
+
+// SynonymSetItemModel describes one item block.
+type SynonymSetItemModel struct {
+	ID       types.String `tfsdk:"id"`
+	Root     types.String `tfsdk:"root"`
+	Synonyms types.List   `tfsdk:"synonyms"`
+}
+
+var synonymSetItemAttrTypes = map[string]attr.Type{
+	"id":       types.StringType,
+	"root":     types.StringType,
+	"synonyms": types.ListType{ElemType: types.StringType},
+}
+
+func (r *SynonymSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = tfnames.TypeName(req.ProviderTypeName, tfnames.ResourceSynonymSet)
+}
+
+func (r *SynonymSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Typesense synonym set and all of its items (Typesense v30+). The whole set is written in one request, so items missing from the configuration are removed from the set. " +
+			"Do not also manage items of the same set with typesense_synonym.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier for the synonym set, the same as name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the synonym set. Attach it to collections with their synonym_sets attribute.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"item": schema.ListNestedBlock{
+				Description: "A synonym rule in the set.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the synonym rule, unique within the set.",
+							Required:    true,
+						},
+						"root": schema.StringAttribute{
+							Description: "For one-way synonyms, the root word that the synonyms map to. Leave empty for multi-way synonyms.",
+							Optional:    true,
+						},
+						"synonyms": schema.ListAttribute{
+							Description: "List of synonym words.",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SynonymSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providertypes.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providertypes.ProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	if providerData.ServerClient == nil {
+		resp.Diagnostics.AddError(
+			"Server API Not Configured",
+			"The server_host and server_api_key must be configured in the provider to manage synonym sets.",
+		)
+		return
+	}
+
+	r.client = providerData.ServerClient
+	r.featureChecker = providerData.FeatureChecker
+}
+
+// ValidateConfig rejects item IDs used more than once, which the server
+// would collapse into a single item.
+func (r *SynonymSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SynonymSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Items.IsNull() || data.Items.IsUnknown() {
+		return
+	}
+
+	var items []SynonymSetItemModel
+	resp.Diagnostics.Append(data.Items.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for i, item := range items {
+		if item.ID.IsNull() || item.ID.IsUnknown() {
+			continue
+		}
+		id := item.ID.ValueString()
+		if seen[id] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("item").AtListIndex(i).AtName("id"),
+				"Duplicate Synonym Item ID",
+				fmt.Sprintf("The item ID %q is used more than once in this synonym set.", id),
+			)
+		}
+		seen[id] = true
+	}
+}
+
+func (r *SynonymSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if diags := version.CheckVersionRequirement(r.featureChecker, version.FeatureSynonymSets, tfnames.FullTypeName(tfnames.ResourceSynonymSet)); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var data SynonymSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	synonymSet, diags := synonymSetFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.UpsertSynonymSet(ctx, synonymSet); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create synonym set: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(synonymSet.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SynonymSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SynonymSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	synonymSet, err := r.client.GetSynonymSet(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read synonym set: %s", err))
+		return
+	}
+
+	if synonymSet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(updateSynonymSetModel(ctx, &data, synonymSet)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SynonymSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SynonymSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	synonymSet, diags := synonymSetFromModel(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.UpsertSynonymSet(ctx, synonymSet); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update synonym set: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SynonymSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SynonymSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSynonymSet(ctx, data.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete synonym set: %s", err))
+		return
+	}
+}
+
+func (r *SynonymSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// synonymSetFromModel builds the synonym set to upsert from the planned items.
+func synonymSetFromModel(ctx context.Context, data SynonymSetResourceModel) (*client.SynonymSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	synonymSet := &client.SynonymSet{
+		Name:     data.Name.ValueString(),
+		Synonyms: []client.SynonymItem{},
+	}
+	if data.Items.IsNull() {
+		return synonymSet, diags
+	}
+
+	var items []SynonymSetItemModel
+	diags.Append(data.Items.ElementsAs(ctx, &items, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, item := range items {
+		var synonyms []string
+		diags.Append(item.Synonyms.ElementsAs(ctx, &synonyms, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		synonymSet.Synonyms = append(synonymSet.Synonyms, client.SynonymItem{
+			ID:       item.ID.ValueString(),
+			Root:     item.Root.ValueString(),
+			Synonyms: synonyms,
+		})
+	}
+	return synonymSet, diags
+}
+
+// updateSynonymSetModel sets the items in data from the server's set. Items
+// keep the order of the prior model, so the server's ordering does not cause
+// index-based diffs; items the prior model does not know come last.
+func updateSynonymSetModel(ctx context.Context, data *SynonymSetResourceModel, synonymSet *client.SynonymSet) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var order []string
+	if !data.Items.IsNull() && !data.Items.IsUnknown() {
+		var prior []SynonymSetItemModel
+		diags.Append(data.Items.ElementsAs(ctx, &prior, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		for _, item := range prior {
+			order = append(order, item.ID.ValueString())
+		}
+	}
+
+	byID := make(map[string]client.SynonymItem, len(synonymSet.Synonyms))
+	for _, item := range synonymSet.Synonyms {
+		byID[item.ID] = item
+	}
+
+	items := make([]client.SynonymItem, 0, len(synonymSet.Synonyms))
+	for _, id := range order {
+		if item, ok := byID[id]; ok {
+			items = append(items, item)
+			delete(byID, id)
+		}
+	}
+	for _, item := range synonymSet.Synonyms {
+		if _, ok := byID[item.ID]; ok {
+			items = append(items, item)
+		}
+	}
+
+	itemValues := make([]attr.Value, len(items))
+	for i, item := range items {
+		synonyms, d := types.ListValueFrom(ctx, types.StringType, item.Synonyms)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		root := types.StringNull()
+		if item.Root != "" {
+			root = types.StringValue(item.Root)
+		}
+
+		itemValues[i], d = types.ObjectValue(synonymSetItemAttrTypes, map[string]attr.Value{
+			"id":       types.StringValue(item.ID),
+			"root":     root,
+			"synonyms": synonyms,
+		})
+		diags.Append(d...)
+	}
+
+	var d diag.Diagnostics
+	data.Items, d = types.ListValue(types.ObjectType{AttrTypes: synonymSetItemAttrTypes}, itemValues)
+	diags.Append(d...)
+	data.ID = types.StringValue(synonymSet.Name)
+	return diags
+}
@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func synonymSetItemValue(id, root string, synonyms ...string) attr.Value {
+	rootValue := types.StringNull()
+	if root != "" {
+		rootValue = types.StringValue(root)
+	}
+	synonymValues := make([]attr.Value, len(synonyms))
+	for i, synonym := range synonyms {
+		synonymValues[i] = types.StringValue(synonym)
+	}
+	return types.ObjectValueMust(synonymSetItemAttrTypes, map[string]attr.Value{
+		"id":       types.StringValue(id),
+		"root":     rootValue,
+		"synonyms": types.ListValueMust(types.StringType, synonymValues),
+	})
+}
+
+func synonymSetModel(name string, items ...attr.Value) SynonymSetResourceModel {
+	return SynonymSetResourceModel{
+		ID:    types.StringUnknown(),
+		Name:  types.StringValue(name),
+		Items: types.ListValueMust(types.ObjectType{AttrTypes: synonymSetItemAttrTypes}, items),
+	}
+}
+
+func TestSynonymSetCreateWritesAllItemsInOneRequest(t *testing.T) {
+	ctx := context.Background()
+
+	var puts []client.SynonymSet
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/synonym_sets/shared" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		var body client.SynonymSet
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		puts = append(puts, body)
+		_, _ = w.Write([]byte(`{"name": "shared", "items": []}`))
+	})
+	r := &SynonymSetResource{
+		client:         newTestServerClient(t, handler),
+		featureChecker: version.NewFeatureChecker(version.MustParse("30.0")),
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, synonymSetModel("shared",
+		synonymSetItemValue("shoes", "", "shoe", "sneaker"),
+		synonymSetItemValue("phone", "smartphone", "iphone"),
+	))
+	if diags.HasError() {
+		t.Fatalf("failed to build plan: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create returned errors: %v", resp.Diagnostics)
+	}
+
+	want := []client.SynonymItem{
+		{ID: "shoes", Synonyms: []string{"shoe", "sneaker"}},
+		{ID: "phone", Root: "smartphone", Synonyms: []string{"iphone"}},
+	}
+	if len(puts) != 1 || !reflect.DeepEqual(puts[0].Synonyms, want) {
+		t.Errorf("PUT requests = %+v, want one with items %+v", puts, want)
+	}
+}
+
+func TestUpdateSynonymSetModelKeepsConfiguredItemOrder(t *testing.T) {
+	ctx := context.Background()
+	data := synonymSetModel("shared",
+		synonymSetItemValue("shoes", "", "shoe", "sneaker"),
+		synonymSetItemValue("phone", "smartphone", "iphone"),
+	)
+
+	diags := updateSynonymSetModel(ctx, &data, &client.SynonymSet{
+		Name: "shared",
+		Synonyms: []client.SynonymItem{
+			{ID: "added-elsewhere", Synonyms: []string{"x", "y"}},
+			{ID: "phone", Root: "smartphone", Synonyms: []string{"iphone"}},
+			{ID: "shoes", Synonyms: []string{"shoe", "sneaker"}},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("updateSynonymSetModel returned errors: %v", diags)
+	}
+
+	want := types.ListValueMust(types.ObjectType{AttrTypes: synonymSetItemAttrTypes}, []attr.Value{
+		synonymSetItemValue("shoes", "", "shoe", "sneaker"),
+		synonymSetItemValue("phone", "smartphone", "iphone"),
+		synonymSetItemValue("added-elsewhere", "", "x", "y"),
+	})
+	if !data.Items.Equal(want) {
+		t.Errorf("items = %v, want %v", data.Items, want)
+	}
+	if data.ID.ValueString() != "shared" {
+		t.Errorf("id = %q, want %q", data.ID.ValueString(), "shared")
+	}
+}
+
+func TestSynonymSetValidateConfigRejectsDuplicateItemIDs(t *testing.T) {
+	ctx := context.Background()
+	r := &SynonymSetResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw}
+	diags := state.Set(ctx, synonymSetModel("shared",
+		synonymSetItemValue("shoes", "", "shoe", "sneaker"),
+		synonymSetItemValue("shoes", "", "boot", "clog"),
+	))
+	if diags.HasError() {
+		t.Fatalf("failed to build config: %v", diags)
+	}
+	config.Raw = state.Raw
+
+	resp := resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+	if errs := resp.Diagnostics.Errors(); len(errs) != 1 || errs[0].Summary() != "Duplicate Synonym Item ID" {
+		t.Errorf("diagnostics = %v, want one duplicate item ID error", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,78 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSynonymSetResource_items(t *testing.T) {
+	rName := acctest.RandomWithPrefix("test-synonym-set")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSynonymSetResourceConfig_twoItems(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "name", rName),
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "id", rName),
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "item.#", "2"),
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "item.0.id", "shoes"),
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "item.0.synonyms.#", "2"),
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "item.1.id", "phone"),
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "item.1.root", "smartphone"),
+				),
+			},
+			{
+				Config: testAccSynonymSetResourceConfig_oneItem(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "item.#", "1"),
+					resource.TestCheckResourceAttr("typesense_synonym_set.test", "item.0.id", "phone"),
+				),
+			},
+			{
+				ResourceName:      "typesense_synonym_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSynonymSetResourceConfig_twoItems(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_synonym_set" "test" {
+  name = %[1]q
+
+  item {
+    id       = "shoes"
+    synonyms = ["shoe", "sneaker"]
+  }
+
+  item {
+    id       = "phone"
+    root     = "smartphone"
+    synonyms = ["iphone", "android"]
+  }
+}
+`, name)
+}
+
+func testAccSynonymSetResourceConfig_oneItem(name string) string {
+	return fmt.Sprintf(`
+resource "typesense_synonym_set" "test" {
+  name = %[1]q
+
+  item {
+    id       = "phone"
+    root     = "smartphone"
+    synonyms = ["iphone", "android"]
+  }
+}
+`, name)
+}
@@ -0,0 +1,218 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alanm/terraform-provider-typesense/internal/client"
+	"github.com/alanm/terraform-provider-typesense/internal/version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeSynonymSets is a minimal in-memory v30 synonym_sets API. wipeOnce lets
+// a test simulate another process re-creating the set (and dropping its
+// items) right after an item write.
+type fakeSynonymSets struct {
+	mu          sync.Mutex
+	sets        map[string]map[string]bool
+	itemPuts    int
+	itemDeletes int
+	setCreate   int
+	wipeOnce    bool
+}
+
+func (f *fakeSynonymSets) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var set, item string
+	parts := splitPath(r.URL.Path)
+	if len(parts) >= 2 && parts[0] == "synonym_sets" {
+		set = parts[1]
+	}
+	if len(parts) == 4 && parts[2] == "items" {
+		item = parts[3]
+	}
+
+	switch {
+	case r.Method == http.MethodGet && item == "":
+		if _, ok := f.sets[set]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "` + set + `", "items": []}`))
+	case r.Method == http.MethodPut && item == "":
+		f.setCreate++
+		f.sets[set] = map[string]bool{}
+		_, _ = w.Write([]byte(`{"name": "` + set + `", "items": []}`))
+	case r.Method == http.MethodPut:
+		f.itemPuts++
+		f.sets[set][item] = true
+		if f.wipeOnce {
+			// A concurrent writer replaces the whole set with an empty one.
+			f.wipeOnce = false
+			f.sets[set] = map[string]bool{}
+		}
+		_, _ = w.Write([]byte(`{"id": "` + item + `", "synonyms": ["a", "b"]}`))
+	case r.Method == http.MethodGet:
+		if !f.sets[set][item] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "` + item + `", "synonyms": ["a", "b"]}`))
+	case r.Method == http.MethodDelete && item != "":
+		f.itemDeletes++
+		if !f.sets[set][item] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.sets[set], item)
+		_, _ = w.Write([]byte(`{"id": "` + item + `"}`))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func splitPath(p string) []string {
+	var parts []string
+	for _, seg := range strings.Split(strings.Trim(p, "/"), "/") {
+		unescaped, _ := url.PathUnescape(seg)
+		parts = append(parts, unescaped)
+	}
+	return parts
+}
+
+func newTestServerClient(t *testing.T, handler http.Handler) *client.ServerClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+	port, err := strconv.Atoi(u.Port())
+	if err != nil {
+		t.Fatalf("failed to parse test server port: %v", err)
+	}
+	return client.NewServerClient(u.Hostname(), "test-api-key", port, "http")
+}
+
+func TestCreateSynonymV30RewritesItemWipedByConcurrentSetCreate(t *testing.T) {
+	fake := &fakeSynonymSets{sets: map[string]map[string]bool{}, wipeOnce: true}
+	r := &SynonymResource{client: newTestServerClient(t, fake)}
+
+	if err := r.createSynonymV30(context.Background(), "products", "shoes", "", []string{"a", "b"}); err != nil {
+		t.Fatalf("createSynonymV30 returned %v", err)
+	}
+
+	if !fake.sets["products"]["shoes"] {
+		t.Error("synonym item missing from the set after createSynonymV30")
+	}
+	if fake.itemPuts != 2 {
+		t.Errorf("item written %d times, want 2 (initial write plus rewrite after the wipe)", fake.itemPuts)
+	}
+}
+
+func TestCreateSynonymV30SkipsSetCreateWhenSetExists(t *testing.T) {
+	fake := &fakeSynonymSets{sets: map[string]map[string]bool{"products": {"existing": true}}}
+	r := &SynonymResource{client: newTestServerClient(t, fake)}
+
+	if err := r.createSynonymV30(context.Background(), "products", "shoes", "", []string{"a", "b"}); err != nil {
+		t.Fatalf("createSynonymV30 returned %v", err)
+	}
+
+	if fake.setCreate != 0 {
+		t.Errorf("synonym set was re-created %d times, want 0", fake.setCreate)
+	}
+	if !fake.sets["products"]["existing"] || !fake.sets["products"]["shoes"] {
+		t.Errorf("set items = %v, want both existing and shoes", fake.sets["products"])
+	}
+}
+
+func TestSynonymMovesItemOutOfV30UpgradeSetOnUpdate(t *testing.T) {
+	// State written against v29; the upgrade moved the synonym into the
+	// products_synonyms_index set.
+	fake := &fakeSynonymSets{sets: map[string]map[string]bool{"products_synonyms_index": {"shoes": true}}}
+	ctx := context.Background()
+	r := &SynonymResource{
+		client:         newTestServerClient(t, fake),
+		featureChecker: version.NewFeatureChecker(version.MustParse("30.0")),
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &SynonymResourceModel{
+		ID:               types.StringValue("products/shoes"),
+		Collection:       types.StringValue("products"),
+		Name:             types.StringValue("shoes"),
+		Root:             types.StringNull(),
+		Synonyms:         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+		UpsertOnConflict: types.BoolValue(false),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build state: %v", diags)
+	}
+
+	// Read finds the item in the upgraded set without writing anything.
+	readResp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+	if readResp.State.Raw.IsNull() {
+		t.Fatal("Read removed the synonym from state, want it found in the upgraded set")
+	}
+	if fake.itemPuts != 0 || fake.setCreate != 0 || fake.itemDeletes != 0 {
+		t.Fatalf("Read wrote to the server: %d item PUTs, %d set creates, %d item deletes", fake.itemPuts, fake.setCreate, fake.itemDeletes)
+	}
+	var id types.String
+	readResp.State.GetAttribute(ctx, path.Root("id"), &id)
+	if id.ValueString() != "products_synonyms_index/shoes" {
+		t.Fatalf("id after Read = %s, want the upgraded set's products_synonyms_index/shoes", id)
+	}
+
+	// The plan moves it back under the collection's set.
+	plan := tfsdk.Plan{Schema: readResp.State.Schema, Raw: readResp.State.Raw.Copy()}
+	planResp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: readResp.State, Plan: plan}, &planResp)
+	if planResp.Diagnostics.HasError() || len(planResp.Diagnostics.Warnings()) != 1 {
+		t.Fatalf("ModifyPlan diagnostics = %v, want a single warning", planResp.Diagnostics)
+	}
+	planResp.Plan.GetAttribute(ctx, path.Root("id"), &id)
+	if id.ValueString() != "products/shoes" {
+		t.Fatalf("planned id = %s, want products/shoes", id)
+	}
+
+	// Update copies it into the collection's set and removes the upgraded copy.
+	updateResp := resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{State: readResp.State, Plan: planResp.Plan}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update returned errors: %v", updateResp.Diagnostics)
+	}
+	if !fake.sets["products"]["shoes"] {
+		t.Errorf("set items = %v, want shoes copied into the products set", fake.sets["products"])
+	}
+	if fake.sets["products_synonyms_index"]["shoes"] {
+		t.Error("shoes is still in products_synonyms_index, want it removed so only one copy applies")
+	}
+	updateResp.State.GetAttribute(ctx, path.Root("id"), &id)
+	if id.ValueString() != "products/shoes" {
+		t.Errorf("id after Update = %s, want products/shoes", id)
+	}
+}
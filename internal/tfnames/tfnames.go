@@ -9,6 +9,7 @@ const (
 	ResourceCollection          = "collection"
 	ResourceCollectionAlias     = "collection_alias"
 	ResourceSynonym             = "synonym"
+	ResourceSynonymSet          = "synonym_set"
 	ResourceOverride            = "override"
 	ResourceStopwordsSet        = "stopwords_set"
 	ResourcePreset              = "preset"
@@ -17,12 +18,32 @@ const (
 	ResourceNLSearchModel       = "nl_search_model"
 	ResourceConversationModel   = "conversation_model"
 	ResourceStemmingDictionary  = "stemming_dictionary"
+	ResourceConfig              = "config"
 )
 
 const (
-	DataSourceCollections = "collections"
-	DataSourceAPIKeys     = "api_keys"
-	DataSourceServerInfo  = "server_info"
+	DataSourceCollections                = "collections"
+	DataSourceAPIKeys                    = "api_keys"
+	DataSourceServerInfo                 = "server_info"
+	DataSourceNLSearchModels             = "nl_search_models"
+	DataSourceStopwords                  = "stopwords"
+	DataSourceStats                      = "stats"
+	DataSourceAnalyticsRules             = "analytics_rules"
+	DataSourceCollection                 = "collection"
+	DataSourceMultiSearch                = "multi_search"
+	DataSourceConversationModels         = "conversation_models"
+	DataSourceCluster                    = "cluster"
+	DataSourceCollectionDocuments        = "collection_documents"
+	DataSourceCollectionSchemaValidation = "collection_schema_validation"
+	DataSourceSynonym                    = "synonym"
+	DataSourceCollectionReadiness        = "collection_readiness"
+	DataSourceStopwordsSets              = "stopwords_sets"
+	DataSourceFeatures                   = "features"
+	DataSourceOverride                   = "override"
+	DataSourceHealth                     = "health"
+	DataSourceMetrics                    = "metrics"
+	DataSourceDocument                   = "document"
+	DataSourceClusterNodes               = "cluster_nodes"
 )
 
 var ResourceNames = []string{
@@ -31,6 +52,7 @@ var ResourceNames = []string{
 	ResourceCollection,
 	ResourceCollectionAlias,
 	ResourceSynonym,
+	ResourceSynonymSet,
 	ResourceOverride,
 	ResourceStopwordsSet,
 	ResourcePreset,
@@ -39,6 +61,7 @@ var ResourceNames = []string{
 	ResourceNLSearchModel,
 	ResourceConversationModel,
 	ResourceStemmingDictionary,
+	ResourceConfig,
 }
 
 var GeneratedResourceNames = []string{
@@ -60,6 +83,25 @@ var DataSourceNames = []string{
 	DataSourceCollections,
 	DataSourceAPIKeys,
 	DataSourceServerInfo,
+	DataSourceNLSearchModels,
+	DataSourceStopwords,
+	DataSourceStats,
+	DataSourceAnalyticsRules,
+	DataSourceCollection,
+	DataSourceMultiSearch,
+	DataSourceConversationModels,
+	DataSourceCluster,
+	DataSourceCollectionDocuments,
+	DataSourceCollectionSchemaValidation,
+	DataSourceSynonym,
+	DataSourceCollectionReadiness,
+	DataSourceStopwordsSets,
+	DataSourceFeatures,
+	DataSourceOverride,
+	DataSourceHealth,
+	DataSourceMetrics,
+	DataSourceDocument,
+	DataSourceClusterNodes,
 }
 
 func TypeName(providerTypeName, name string) string {
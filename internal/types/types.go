@@ -19,4 +19,8 @@ type ProviderData struct {
 	// When ServerVersion is nil, this will be a FallbackFeatureChecker
 	// that returns false for all features, triggering runtime detection.
 	FeatureChecker version.FeatureChecker
+
+	// CollectionNamePrefix is prepended to collection and alias names sent to
+	// the server. Configuration always uses the unprefixed names.
+	CollectionNamePrefix string
 }
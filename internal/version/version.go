@@ -260,6 +260,21 @@ const (
 	FeatureStemmingDictionaries Feature = "stemming_dictionaries"
 )
 
+// Features lists every Feature in declaration order, e.g. for exposing the
+// whole feature matrix to configurations.
+var Features = []Feature{
+	FeatureSynonymSets,
+	FeatureCurationSets,
+	FeaturePerCollectionSynonyms,
+	FeaturePerCollectionOverrides,
+	FeatureConversationModels,
+	FeaturePresets,
+	FeatureStopwords,
+	FeatureAnalyticsRules,
+	FeatureNLSearchModels,
+	FeatureStemmingDictionaries,
+}
+
 // featureVersions maps features to their minimum required version.
 // nil means the feature has no minimum version (always available).
 var featureVersions = map[Feature]*Version{
@@ -356,6 +371,26 @@ func (c *FallbackFeatureChecker) GetVersion() *Version {
 	return nil
 }
 
+// UngatedFeatureChecker wraps a FeatureChecker for the provider's
+// ignore_version_checks option. Feature questions are answered by the wrapped
+// checker, so the choice between version-specific APIs is unchanged, but
+// CheckVersionRequirement lets every resource through and the server decides.
+type UngatedFeatureChecker struct {
+	FeatureChecker
+}
+
+// NewUngatedFeatureChecker wraps checker so that version requirements are not enforced.
+func NewUngatedFeatureChecker(checker FeatureChecker) FeatureChecker {
+	return &UngatedFeatureChecker{FeatureChecker: checker}
+}
+
+// VersionChecksIgnored reports whether checker was created with
+// NewUngatedFeatureChecker, i.e. version requirements should not be enforced.
+func VersionChecksIgnored(checker FeatureChecker) bool {
+	_, ok := checker.(*UngatedFeatureChecker)
+	return ok
+}
+
 // featureMinVersionString returns a human-readable minimum version string for a feature.
 func featureMinVersionString(feature Feature) string {
 	if minVer, ok := featureVersions[feature]; ok && minVer != nil {
@@ -366,11 +401,12 @@ func featureMinVersionString(feature Feature) string {
 
 // CheckVersionRequirement checks if the server version supports the given feature
 // and returns an error diagnostic if it does not. When the server version is unknown
-// (FallbackFeatureChecker), the check is skipped to allow runtime detection.
+// (FallbackFeatureChecker), the check is skipped to allow runtime detection,
+// and so it is when version checks are ignored (UngatedFeatureChecker).
 func CheckVersionRequirement(checker FeatureChecker, feature Feature, resourceName string) diag.Diagnostics {
 	// If version is unknown, skip the guard and let the API call fail naturally.
 	// This allows runtime detection via 404 handling.
-	if checker.GetVersion() == nil {
+	if checker.GetVersion() == nil || VersionChecksIgnored(checker) {
 		return nil
 	}
 
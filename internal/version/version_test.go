@@ -457,6 +457,17 @@ func TestCheckVersionRequirement(t *testing.T) {
 		}
 	})
 
+	t.Run("skips check when version checks are ignored", func(t *testing.T) {
+		checker := NewUngatedFeatureChecker(NewFeatureChecker(MustParse("26.0")))
+		diags := CheckVersionRequirement(checker, FeaturePresets, tfnames.FullTypeName(tfnames.ResourcePreset))
+		if diags != nil {
+			t.Errorf("expected nil diagnostics with version checks ignored, got: %v", diags)
+		}
+		if checker.SupportsFeature(FeaturePresets) || !checker.SupportsFeature(FeaturePerCollectionSynonyms) {
+			t.Error("ungated checker should still report the wrapped checker's features")
+		}
+	})
+
 	t.Run("error message for each feature type", func(t *testing.T) {
 		featureTests := []struct {
 			feature     Feature
@@ -516,3 +527,18 @@ func TestFeatureMinVersionString(t *testing.T) {
 		})
 	}
 }
+
+func TestFeaturesListsEveryFeature(t *testing.T) {
+	listed := make(map[Feature]bool, len(Features))
+	for _, feature := range Features {
+		if listed[feature] {
+			t.Errorf("feature %q is listed twice", feature)
+		}
+		listed[feature] = true
+	}
+	for feature := range featureVersions {
+		if !listed[feature] {
+			t.Errorf("feature %q is missing from Features", feature)
+		}
+	}
+}
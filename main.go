@@ -5,11 +5,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 
+	"github.com/alanm/terraform-provider-typesense/cmd/diff"
 	"github.com/alanm/terraform-provider-typesense/cmd/generate"
 	"github.com/alanm/terraform-provider-typesense/cmd/migrate"
 	"github.com/alanm/terraform-provider-typesense/internal/provider"
@@ -45,6 +47,16 @@ func main() {
 				os.Exit(1)
 			}
 			return
+		case "diff":
+			if err := diff.Run(os.Args[2:]); err != nil {
+				// Differences have been printed; exit 2 tells them apart from errors.
+				if errors.Is(err, diff.ErrDifferences) {
+					os.Exit(2)
+				}
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
 		case "migrate":
 			if err := migrate.Run(os.Args[2:]); err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -86,6 +98,7 @@ Usage:
 
 Commands:
   generate    Generate Terraform configuration from existing Typesense resources
+  diff        Compare existing Typesense resources with Terraform configuration
   migrate     Import collections and documents to a target cluster
   version     Print version information
   help        Show this help message
@@ -94,6 +107,7 @@ When run without a command, the provider starts in Terraform plugin mode.
 
 For command-specific help:
   terraform-provider-typesense generate --help
+  terraform-provider-typesense diff --help
   terraform-provider-typesense migrate --help
 `, version)
 }